@@ -7,8 +7,11 @@ import (
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
 
+	"Docker_Container_monitor/internal/alerts"
 	"Docker_Container_monitor/internal/api"
 	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/fleet"
 	"Docker_Container_monitor/internal/ssh"
 )
 
@@ -20,7 +23,18 @@ func main() {
 			&cli.BoolFlag{Name: "json", Usage: "JSON output"},
 		},
 		Commands: []*cli.Command{
-			{Name: "state", Usage: "Show container states", Action: docker.StateCmd},
+			{
+				Name:   "state",
+				Usage:  "Show container states",
+				Action: docker.StateCmd,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "format", Usage: "'table' (default), 'table TEMPLATE' for custom columns, 'json', or a bare Go text/template, e.g. '{{.ID}}\\t{{index .Names 0}}\\t{{.Status}}'. Falls back to PsFormat in ~/.docker_monitor.yaml when unset"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter containers, e.g. 'status=running', 'label=env=prod', 'uptime>1h' (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
+					&cli.BoolFlag{Name: "watch", Usage: "Redraw in place on container events and every --interval"},
+					&cli.DurationFlag{Name: "interval", Value: 2 * time.Second, Usage: "Redraw interval for --watch"},
+				},
+			},
 			{
 				Name:  "service",
 				Usage: "HTTP health checks",
@@ -37,6 +51,8 @@ func main() {
 				Flags: []cli.Flag{
 					&cli.IntFlag{Name: "tail", Value: 100, Usage: "Lines to show"},
 					&cli.BoolFlag{Name: "follow", Aliases: []string{"f"}, Usage: "Follow logs"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Select the container by filter instead of by name, must match exactly one (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
 				},
 			},
 			{
@@ -45,23 +61,108 @@ func main() {
 				Action: docker.StatsCmd,
 				Flags: []cli.Flag{
 					&cli.DurationFlag{Name: "interval", Value: time.Second, Usage: "Refresh interval"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter containers, e.g. 'status=running', 'label=env=prod', 'cpu>50' (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
+				},
+			},
+			{
+				Name:   "top",
+				Usage:  "Show running processes of containers",
+				Action: docker.TopCmd,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "ps-args", Value: "-ef", Usage: "ps options to pass through to the container's top, e.g. '-ef'"},
+					&cli.BoolFlag{Name: "json", Usage: "Machine-readable output, processes grouped by container name"},
+					&cli.BoolFlag{Name: "pid-only", Usage: "Print a bare PID list across all matched containers"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter containers, e.g. 'status=running', 'label=env=prod', 'uptime>1h' (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
+				},
+			},
+			{
+				Name:   "watch",
+				Usage:  "Like state, but redraws in place on container events and every --interval",
+				Action: docker.WatchCmd,
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter containers, e.g. 'status=running', 'label=env=prod', 'uptime>1h' (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
+					&cli.DurationFlag{Name: "interval", Value: 2 * time.Second, Usage: "Redraw interval"},
+				},
+			},
+			{
+				Name:   "status",
+				Usage:  "Show a rich per-container summary (health, IPs, ports)",
+				Action: docker.StatusCmd,
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "concurrency", Value: 8, Usage: "Max concurrent container inspects"},
+					&cli.StringFlag{Name: "format", Usage: "Format each summary with a Go text/template, e.g. '{{.Name}} {{.State}}'"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter containers, e.g. 'status=running', 'label=env=prod', 'uptime>1h' (repeatable)"},
+					&cli.BoolFlag{Name: "filter-help", Usage: "Print the supported --filter keys and examples, then exit"},
 				},
 			},
-			{Name: "events", Usage: "Monitor Docker events", Action: docker.EventsCmd},
 			{
-				Name:   "remote",
-				Usage:  "Monitor remote Docker via SSH",
-				Action: ssh.RemoteCmd,
+				Name:   "events",
+				Usage:  "Monitor Docker events",
+				Action: docker.EventsCmd,
 				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "since", Usage: "Show events created since this timestamp (RFC3339 or Unix seconds)"},
+					&cli.StringFlag{Name: "until", Usage: "Stream events until this timestamp (RFC3339 or Unix seconds)"},
+					&cli.StringSliceFlag{Name: "filter", Usage: "Filter events, e.g. 'type=container', 'event=die', 'label=foo=bar' (repeatable)"},
+				},
+			},
+			{
+				Name:   "alert",
+				Usage:  "Watch Docker events and post matching ones to webhooks",
+				Action: alerts.AlertCmd,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "config", Usage: "Path to an alerts config file (overrides --rule/--webhook)"},
+					&cli.StringSliceFlag{Name: "rule", Usage: "Rule expression, e.g. 'type=container,action=die,exitCode!=0' (repeatable)"},
+					&cli.StringSliceFlag{Name: "webhook", Usage: "Webhook URL to notify for every --rule (repeatable)"},
+					&cli.StringFlag{Name: "secret", Usage: "Shared secret for the HMAC-SHA256 X-Signature header"},
+					&cli.Float64Flag{Name: "rate", Value: 1, Usage: "Max alerts per second per rule"},
+					&cli.IntFlag{Name: "burst", Value: 5, Usage: "Token bucket burst size per rule"},
+					&cli.IntFlag{Name: "max-retries", Value: 3, Usage: "Webhook delivery retries before dead-lettering"},
+					&cli.DurationFlag{Name: "backoff", Value: 2 * time.Second, Usage: "Base backoff between webhook retries"},
+					&cli.StringFlag{Name: "dead-letter", Usage: "Path to append deliveries that exhaust their retries"},
+				},
+			},
+			{
+				Name:  "remote",
+				Usage: "Monitor remote Docker via SSH, or a whole --fleet of hosts at once",
+				Action: func(c *cli.Context) error {
+					if c.String("fleet") != "" {
+						return fleet.RemoteCmd(c)
+					}
+					return ssh.RemoteCmd(c)
+				},
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "host", Usage: "SSH config alias from ~/.ssh/config; accepts a comma-separated list or a wildcard group, e.g. 'prod-*'"},
 					&cli.StringFlag{Name: "key", Aliases: []string{"i"}, Usage: "SSH private key path"},
+					&cli.StringFlag{Name: "passphrase", Usage: "Passphrase for the private key, if encrypted"},
+					&cli.BoolFlag{Name: "insecure-host-key", Usage: "Skip known_hosts verification (not recommended)"},
+					&cli.StringFlag{Name: "fleet", Usage: "Path to a hosts.yaml inventory to query many hosts concurrently instead of --host/manual"},
 				},
 			},
 			{
 				Name:   "serve",
-				Usage:  "Run HTTP & Prometheus server",
+				Usage:  "Run HTTP & Prometheus server, with a /v1 JSON API (containers, stats, services, events, logs)",
 				Action: api.ServeCmd,
 				Flags: []cli.Flag{
 					&cli.IntFlag{Name: "port", Value: 9090, Usage: "Server port"},
+					&cli.DurationFlag{Name: "scrape-timeout", Value: 5 * time.Second, Usage: "Max time to collect metrics from the Docker daemon"},
+					&cli.StringFlag{Name: "fleet", Usage: "Path to a hosts.yaml to monitor a fleet of remote Docker daemons over SSH"},
+					&cli.StringFlag{Name: "alerts-config", Usage: "Path to an alerts config file to run event-driven webhook alerting in-process"},
+					&cli.StringFlag{Name: "token", Usage: "If set, require 'Authorization: Bearer <token>' on every /v1 request"},
+					&cli.BoolFlag{Name: "cors", Usage: "Send permissive CORS headers on /v1 so browser dashboards can call the API cross-origin"},
+					&cli.DurationFlag{Name: "service-threshold", Value: 200 * time.Millisecond, Usage: "Response time above which /v1/services reports a probe as slow"},
+				},
+			},
+			{
+				Name:   "exporter",
+				Usage:  "Run a standalone Prometheus exporter for container and service-probe metrics",
+				Action: api.ExporterCmd,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "listen", Value: ":9100", Usage: "Address to listen on"},
+					&cli.DurationFlag{Name: "scrape-timeout", Value: 5 * time.Second, Usage: "Max time to collect metrics from the Docker daemon per scrape"},
+					&cli.DurationFlag{Name: "service-threshold", Value: 200 * time.Millisecond, Usage: "Response time above which a monitor_service_probe_up reports a probe as down instead of up"},
 				},
 			},
 		},
@@ -70,6 +171,25 @@ func main() {
 
 	if err := app.Run(os.Args); err != nil {
 		color.Red("%v", err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode maps an errdefs-classified error to a distinct process exit
+// code so scripts can react without parsing the message.
+func exitCode(err error) int {
+	switch {
+	case errdefs.IsNotFound(err):
+		return 2
+	case errdefs.IsInvalidParameter(err):
+		return 3
+	case errdefs.IsUnauthorized(err):
+		return 4
+	case errdefs.IsConflict(err):
+		return 5
+	case errdefs.IsUnavailable(err):
+		return 6
+	default:
+		return 1
 	}
 }
@@ -0,0 +1,79 @@
+package alerts
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/errdefs"
+)
+
+// AlertCmd streams Docker events and posts matching ones to webhooks,
+// either from a --config file or from repeated --rule/--webhook flags.
+func AlertCmd(c *cli.Context) error {
+	cfg, err := configFromFlags(c)
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	engine, err := NewEngine(cfg)
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	backend, err := docker.NewBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+	defer backend.Close()
+
+	return engine.Run(c.Context, backend)
+}
+
+// configFromFlags loads a Config from --config if set, otherwise builds
+// one rule per --rule, all firing to every --webhook.
+func configFromFlags(c *cli.Context) (*Config, error) {
+	if path := c.String("config"); path != "" {
+		return LoadConfig(path)
+	}
+
+	rules := c.StringSlice("rule")
+	webhooks := c.StringSlice("webhook")
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("at least one --rule (or --config) is required")
+	}
+	if len(webhooks) == 0 {
+		return nil, fmt.Errorf("at least one --webhook (or --config) is required")
+	}
+
+	cfg := &Config{
+		Secret:     c.String("secret"),
+		DeadLetter: c.String("dead-letter"),
+		MaxRetries: c.Int("max-retries"),
+		Backoff:    c.Duration("backoff"),
+	}
+	for _, expr := range rules {
+		cfg.Rules = append(cfg.Rules, RuleConfig{
+			Expr:       expr,
+			Webhooks:   webhooks,
+			RatePerSec: c.Float64("rate"),
+			Burst:      c.Int("burst"),
+		})
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = defaultBackoff
+	}
+	for i := range cfg.Rules {
+		if cfg.Rules[i].RatePerSec <= 0 {
+			cfg.Rules[i].RatePerSec = defaultRatePerSec
+		}
+		if cfg.Rules[i].Burst <= 0 {
+			cfg.Rules[i].Burst = defaultBurst
+		}
+	}
+	return cfg, nil
+}
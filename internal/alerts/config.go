@@ -0,0 +1,71 @@
+package alerts
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleConfig is one rule entry of an alerts config file.
+type RuleConfig struct {
+	Expr       string   `yaml:"expr"`
+	Webhooks   []string `yaml:"webhooks"`
+	RatePerSec float64  `yaml:"rate_per_sec"`
+	Burst      int      `yaml:"burst"`
+}
+
+// Config is the full alerts configuration, used by both `monitor alert
+// --config` and `monitor serve --alerts-config`.
+type Config struct {
+	Secret     string        `yaml:"secret"`
+	DeadLetter string        `yaml:"dead_letter"`
+	MaxRetries int           `yaml:"max_retries"`
+	Backoff    time.Duration `yaml:"backoff"`
+	Rules      []RuleConfig  `yaml:"rules"`
+}
+
+const (
+	defaultRatePerSec = 1.0
+	defaultBurst      = 5
+	defaultMaxRetries = 3
+	defaultBackoff    = 2 * time.Second
+)
+
+// LoadConfig reads and validates an alerts config file, filling in
+// defaults for unset rate limit, retry and backoff fields.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read alerts config: %v", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parse alerts config: %v", err)
+	}
+	if len(cfg.Rules) == 0 {
+		return nil, fmt.Errorf("alerts config %s defines no rules", path)
+	}
+	for i, r := range cfg.Rules {
+		if r.Expr == "" {
+			return nil, fmt.Errorf("alerts config %s: rule %d missing expr", path, i)
+		}
+		if len(r.Webhooks) == 0 {
+			return nil, fmt.Errorf("alerts config %s: rule %q has no webhooks", path, r.Expr)
+		}
+		if cfg.Rules[i].RatePerSec <= 0 {
+			cfg.Rules[i].RatePerSec = defaultRatePerSec
+		}
+		if cfg.Rules[i].Burst <= 0 {
+			cfg.Rules[i].Burst = defaultBurst
+		}
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = defaultBackoff
+	}
+	return &cfg, nil
+}
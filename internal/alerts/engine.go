@@ -0,0 +1,81 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+
+	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/errdefs"
+)
+
+// boundRule pairs a parsed Rule with the webhooks it fires and its own
+// rate limiter.
+type boundRule struct {
+	rule     *Rule
+	webhooks []string
+	limiter  *Limiter
+}
+
+// Engine streams Docker events and dispatches matching ones to their
+// rules' webhooks.
+type Engine struct {
+	rules    []boundRule
+	delivery *Delivery
+}
+
+// NewEngine builds an Engine from cfg.
+func NewEngine(cfg *Config) (*Engine, error) {
+	delivery := NewDelivery(cfg.Secret, cfg.MaxRetries, cfg.Backoff, cfg.DeadLetter)
+
+	rules := make([]boundRule, 0, len(cfg.Rules))
+	for _, rc := range cfg.Rules {
+		rule, err := ParseRule(rc.Expr)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %v", rc.Expr, err)
+		}
+		rules = append(rules, boundRule{
+			rule:     rule,
+			webhooks: rc.Webhooks,
+			limiter:  NewLimiter(rc.RatePerSec, rc.Burst),
+		})
+	}
+
+	return &Engine{rules: rules, delivery: delivery}, nil
+}
+
+// Run streams Docker events from backend and dispatches matching events
+// to their rules' webhooks until ctx is done or the event stream errors.
+func (e *Engine) Run(ctx context.Context, backend docker.Backend) error {
+	msgChan, errChan := backend.Events(ctx, types.EventsOptions{})
+	for {
+		select {
+		case msg := <-msgChan:
+			e.dispatch(ctx, msg)
+		case err := <-errChan:
+			return errdefs.Unavailable(fmt.Errorf("event stream error: %v", err))
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dispatch fires webhooks for every rule msg matches and whose rate
+// limiter still has capacity.
+func (e *Engine) dispatch(ctx context.Context, msg events.Message) {
+	for _, br := range e.rules {
+		if !br.rule.Match(msg) || !br.limiter.Allow() {
+			continue
+		}
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			continue
+		}
+		for _, url := range br.webhooks {
+			go e.delivery.Send(ctx, url, payload)
+		}
+	}
+}
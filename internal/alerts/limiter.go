@@ -0,0 +1,47 @@
+package alerts
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter is a simple token-bucket rate limiter used to cap how often a
+// single rule may fire webhooks, independent of how fast matching events
+// arrive.
+type Limiter struct {
+	mu       sync.Mutex
+	tokens   float64
+	max      float64
+	refill   float64 // tokens per second
+	lastFill time.Time
+}
+
+// NewLimiter returns a Limiter that allows ratePerSec events per second on
+// average, bursting up to burst at once.
+func NewLimiter(ratePerSec float64, burst int) *Limiter {
+	return &Limiter{
+		tokens:   float64(burst),
+		max:      float64(burst),
+		refill:   ratePerSec,
+		lastFill: time.Now(),
+	}
+}
+
+// Allow reports whether a token is available and, if so, consumes it.
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.lastFill).Seconds() * l.refill
+	if l.tokens > l.max {
+		l.tokens = l.max
+	}
+	l.lastFill = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
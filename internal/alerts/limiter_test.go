@@ -0,0 +1,16 @@
+package alerts
+
+import "testing"
+
+func TestLimiterAllowsUpToBurstThenBlocks(t *testing.T) {
+	l := NewLimiter(1, 2)
+	if !l.Allow() {
+		t.Error("expected first token to be allowed")
+	}
+	if !l.Allow() {
+		t.Error("expected second token (burst) to be allowed")
+	}
+	if l.Allow() {
+		t.Error("expected a third immediate call to be rate limited")
+	}
+}
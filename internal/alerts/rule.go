@@ -0,0 +1,99 @@
+// Package alerts turns the Docker events stream into webhook
+// notifications: rules select which events matter, and matching events
+// are posted to one or more webhooks with signing, retry/backoff and
+// per-rule rate limiting.
+package alerts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types/events"
+)
+
+// attrMatch is one "key=value" or "key!=value" clause of a rule
+// expression, matched against the event's type/action or, for any other
+// key, its Actor.Attributes.
+type attrMatch struct {
+	key    string
+	value  string
+	negate bool
+}
+
+// Rule matches Docker events by type, action, and arbitrary actor
+// attributes (e.g. exitCode for container "die" events).
+type Rule struct {
+	Expr   string
+	Type   string
+	Action string
+	Attrs  []attrMatch
+}
+
+// ParseRule parses a rule expression of the form
+// "type=container,action=die,exitCode!=0" into a Rule. Each clause is a
+// comma-separated "key=value" or "key!=value" pair; "type" and "action"
+// match events.Message.Type/Action directly, any other key matches
+// events.Message.Actor.Attributes[key].
+func ParseRule(expr string) (*Rule, error) {
+	rule := &Rule{Expr: expr}
+	for _, clause := range strings.Split(expr, ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+
+		negate := false
+		sep := "="
+		if strings.Contains(clause, "!=") {
+			negate = true
+			sep = "!="
+		}
+		parts := strings.SplitN(clause, sep, 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid clause %q in rule %q", clause, expr)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		switch {
+		case key == "type" && !negate:
+			rule.Type = value
+		case key == "action" && !negate:
+			rule.Action = value
+		default:
+			rule.Attrs = append(rule.Attrs, attrMatch{key: key, value: value, negate: negate})
+		}
+	}
+	if rule.Type == "" && rule.Action == "" && len(rule.Attrs) == 0 {
+		return nil, fmt.Errorf("rule %q matches nothing", expr)
+	}
+	return rule, nil
+}
+
+// Match reports whether event satisfies every clause of the rule.
+func (r *Rule) Match(event events.Message) bool {
+	if r.Type != "" && event.Type != r.Type {
+		return false
+	}
+	if r.Action != "" && event.Action != r.Action {
+		return false
+	}
+	for _, a := range r.Attrs {
+		val, ok := event.Actor.Attributes[a.key]
+		switch a.key {
+		case "type":
+			val, ok = event.Type, true
+		case "action":
+			val, ok = event.Action, true
+		}
+		if a.negate {
+			if ok && val == a.value {
+				return false
+			}
+			continue
+		}
+		if !ok || val != a.value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/events"
+)
+
+func TestParseRuleTypeActionAttr(t *testing.T) {
+	rule, err := ParseRule("type=container,action=die,exitCode!=0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.Type != "container" || rule.Action != "die" {
+		t.Fatalf("got Type=%q Action=%q, want container/die", rule.Type, rule.Action)
+	}
+	if len(rule.Attrs) != 1 || rule.Attrs[0].key != "exitCode" || !rule.Attrs[0].negate {
+		t.Fatalf("expected a negated exitCode attribute, got %v", rule.Attrs)
+	}
+}
+
+func TestParseRuleEmptyMatchesNothing(t *testing.T) {
+	if _, err := ParseRule(""); err == nil {
+		t.Fatal("expected an error for an empty rule")
+	}
+}
+
+func TestRuleMatch(t *testing.T) {
+	rule, err := ParseRule("type=container,action=die,exitCode!=0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	crash := events.Message{
+		Type:   "container",
+		Action: "die",
+		Actor:  events.Actor{Attributes: map[string]string{"exitCode": "1"}},
+	}
+	if !rule.Match(crash) {
+		t.Error("expected a nonzero-exit die event to match")
+	}
+
+	clean := events.Message{
+		Type:   "container",
+		Action: "die",
+		Actor:  events.Actor{Attributes: map[string]string{"exitCode": "0"}},
+	}
+	if rule.Match(clean) {
+		t.Error("expected a clean exit not to match exitCode!=0")
+	}
+
+	other := events.Message{Type: "network", Action: "connect"}
+	if rule.Match(other) {
+		t.Error("expected a non-container event not to match")
+	}
+}
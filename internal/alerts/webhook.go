@@ -0,0 +1,126 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Delivery posts alert payloads to webhook URLs, signing each with
+// HMAC-SHA256 and retrying with exponential backoff. Deliveries that
+// exhaust their retries are appended to a dead-letter log if one is
+// configured.
+type Delivery struct {
+	client     *http.Client
+	secret     string
+	maxRetries int
+	backoff    time.Duration
+	deadLetter string
+
+	mu sync.Mutex // guards dead-letter file writes
+}
+
+// NewDelivery returns a Delivery. A webhook call is retried up to
+// maxRetries times with exponentially increasing backoff starting at
+// backoff; deadLetterPath may be empty to disable the dead-letter log.
+func NewDelivery(secret string, maxRetries int, backoff time.Duration, deadLetterPath string) *Delivery {
+	return &Delivery{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		secret:     secret,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		deadLetter: deadLetterPath,
+	}
+}
+
+// Send POSTs payload to url, signing it with X-Signature, and retries on
+// failure or a non-2xx response until maxRetries is exhausted.
+func (d *Delivery) Send(ctx context.Context, url string, payload []byte) error {
+	sig := sign(d.secret, payload)
+
+	var lastErr error
+	for attempt := 0; attempt <= d.maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := d.backoff * time.Duration(uint(1)<<uint(attempt-1))
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Signature", sig)
+
+		resp, err := d.client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("webhook %s returned %s", url, resp.Status)
+	}
+
+	d.writeDeadLetter(url, payload, lastErr)
+	return lastErr
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of payload using secret.
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// deadLetterEntry is one line of the dead-letter log.
+type deadLetterEntry struct {
+	Time    string          `json:"time"`
+	Webhook string          `json:"webhook"`
+	Error   string          `json:"error"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+func (d *Delivery) writeDeadLetter(url string, payload []byte, cause error) {
+	if d.deadLetter == "" {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	f, err := os.OpenFile(d.deadLetter, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	errMsg := ""
+	if cause != nil {
+		errMsg = cause.Error()
+	}
+	b, err := json.Marshal(deadLetterEntry{
+		Time:    time.Now().Format(time.RFC3339),
+		Webhook: url,
+		Error:   errMsg,
+		Payload: payload,
+	})
+	if err != nil {
+		return
+	}
+	f.Write(append(b, '\n'))
+}
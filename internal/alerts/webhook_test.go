@@ -0,0 +1,71 @@
+package alerts
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDeliverySendSignsAndSucceeds(t *testing.T) {
+	const secret = "s3cr3t"
+	payload := []byte(`{"hello":"world"}`)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		want := hex.EncodeToString(mac.Sum(nil))
+		if got := r.Header.Get("X-Signature"); got != want {
+			t.Errorf("X-Signature = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	d := NewDelivery(secret, 3, time.Millisecond, "")
+	if err := d.Send(context.Background(), srv.URL, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeliverySendRetriesThenSucceeds(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	d := NewDelivery("secret", 5, time.Millisecond, "")
+	if err := d.Send(context.Background(), srv.URL, []byte(`{}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestDeliverySendExhaustsRetries(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	d := NewDelivery("secret", 2, time.Millisecond, "")
+	if err := d.Send(context.Background(), srv.URL, []byte(`{}`)); err == nil {
+		t.Fatal("expected an error once retries are exhausted")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected maxRetries+1 = 3 attempts, got %d", got)
+	}
+}
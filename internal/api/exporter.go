@@ -0,0 +1,38 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fatih/color"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/metrics"
+)
+
+// ExporterCmd starts a standalone Prometheus exporter on --listen,
+// scraping both per-container metrics (the same ones "monitor serve"
+// exposes on /metrics) and service-probe results as
+// monitor_service_probe_up{container,port,probe}, so a deployment that
+// only wants Prometheus scraping doesn't need to run the full /v1 API.
+func ExporterCmd(c *cli.Context) error {
+	listen := c.String("listen")
+	scrapeTimeout := c.Duration("scrape-timeout")
+
+	backend, err := docker.NewBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics.NewCollector(localHost, backend, scrapeTimeout))
+	registry.MustRegister(metrics.NewProbeCollector(backend, c.Duration("service-threshold"), scrapeTimeout))
+
+	http.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	color.Green("Starting Prometheus exporter on %s/metrics", listen)
+	return http.ListenAndServe(listen, nil)
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestAuthMiddlewareNoToken(t *testing.T) {
+	h := authMiddleware("")(okHandler())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/containers", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 with no token configured, got %d", rec.Code)
+	}
+}
+
+func TestAuthMiddlewareRejectsMissingOrWrongBearer(t *testing.T) {
+	h := authMiddleware("secret")(okHandler())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/containers", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with no Authorization header, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/containers", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with a wrong token, got %d", rec.Code)
+	}
+}
+
+func TestAuthMiddlewareAcceptsMatchingBearer(t *testing.T) {
+	h := authMiddleware("secret")(okHandler())
+	req := httptest.NewRequest(http.MethodGet, "/v1/containers", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 with a matching token, got %d", rec.Code)
+	}
+}
+
+func TestCorsMiddlewareShortCircuitsPreflight(t *testing.T) {
+	h := corsMiddleware(okHandler())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodOptions, "/v1/containers", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 for an OPTIONS preflight, got %d", rec.Code)
+	}
+	if rec.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Fatalf("expected permissive CORS headers, got %v", rec.Header())
+	}
+}
@@ -1,36 +1,163 @@
 package api
 
 import (
-	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
-	"os/exec"
-	"strings"
+	"sync"
 
+	"github.com/docker/docker/api/types"
 	"github.com/fatih/color"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/alerts"
+	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/fleet"
+	"Docker_Container_monitor/internal/metrics"
 )
 
-// ServeCmd starts HTTP server with /metrics & /status
+const localHost = "local"
+
+// hostContainers is one host's contribution to the /status response.
+type hostContainers struct {
+	Host       string            `json:"host"`
+	Containers []types.Container `json:"containers,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// ServeCmd starts HTTP server with /metrics, /status, /hosts, and the
+// /v1 JSON API (/v1/containers, /v1/stats, /v1/services, /v1/events SSE,
+// /v1/logs/{name} chunked), the latter behind a middleware chain
+// (panic recovery, logging, optional CORS, optional bearer-token auth,
+// gzip). With --fleet <path>, it also queries each configured remote
+// host over its pooled SSH tunnel, merging their containers into
+// /status and their metrics into the same Prometheus registry with a
+// "host" label.
 func ServeCmd(c *cli.Context) error {
 	port := c.Int("port")
-	http.Handle("/metrics", promhttp.Handler())
-	http.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
-		out, err := exec.Command("docker", "ps", "--format", "{{json .}}").Output()
+	scrapeTimeout := c.Duration("scrape-timeout")
+
+	backend, err := docker.NewBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(metrics.NewCollector(localHost, backend, scrapeTimeout))
+
+	var pool *fleet.Pool
+	if path := c.String("fleet"); path != "" {
+		hosts, err := fleet.LoadHosts(path)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return errdefs.InvalidParameter(fmt.Errorf("fleet config error: %v", err))
+		}
+		pool = fleet.NewPool(hosts)
+		for _, h := range hosts {
+			registry.MustRegister(metrics.NewCollector(h.Alias, pool.HostBackend(h), scrapeTimeout))
+		}
+	}
+
+	if path := c.String("alerts-config"); path != "" {
+		alertsCfg, err := alerts.LoadConfig(path)
+		if err != nil {
+			return errdefs.InvalidParameter(fmt.Errorf("alerts config error: %v", err))
+		}
+		engine, err := alerts.NewEngine(alertsCfg)
+		if err != nil {
+			return errdefs.InvalidParameter(fmt.Errorf("alerts config error: %v", err))
+		}
+		go func() {
+			if err := engine.Run(c.Context, backend); err != nil {
+				color.Red("alerts: %v", err)
+			}
+		}()
+	}
+
+	http.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	http.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		results := []hostContainers{queryHost(r.Context(), localHost, backend)}
+
+		if pool != nil {
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			for _, h := range pool.Hosts() {
+				wg.Add(1)
+				go func(h fleet.Host) {
+					defer wg.Done()
+					hb, err := pool.Backend(h)
+					var res hostContainers
+					if err != nil {
+						res = hostContainers{Host: h.Alias, Error: err.Error()}
+					} else {
+						res = queryHost(r.Context(), h.Alias, hb)
+					}
+					mu.Lock()
+					results = append(results, res)
+					mu.Unlock()
+				}(h)
+			}
+			wg.Wait()
 		}
-		lines := bytes.Split(bytes.TrimSpace(out), []byte("\n"))
-		// convert to []string
-		str := make([]string, len(lines))
-		for i, l := range lines {
-			str[i] = string(l)
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(results)
+	})
+
+	http.HandleFunc("/hosts", func(w http.ResponseWriter, r *http.Request) {
+		var statuses []fleet.HostStatus
+		if pool != nil {
+			statuses = pool.Status()
 		}
 		w.Header().Set("Content-Type", "application/json")
-		w.Write([]byte("[" + strings.Join(str, ",") + "]"))
+		json.NewEncoder(w).Encode(statuses)
 	})
+
+	mw := []middleware{recoveryMiddleware, loggingMiddleware}
+	if c.Bool("cors") {
+		mw = append(mw, corsMiddleware)
+	}
+	mw = append(mw, authMiddleware(c.String("token")), gzipMiddleware)
+
+	http.Handle("/v1/containers", chain(handleContainers(backend), mw...))
+	http.Handle("/v1/stats", chain(handleStats(backend), mw...))
+	http.Handle("/v1/services", chain(handleServices(backend, c.Duration("service-threshold")), mw...))
+	http.Handle("/v1/events", chain(handleEvents(backend), mw...))
+	http.Handle("/v1/logs/", chain(handleLogs(backend), mw...))
+
 	color.Green("Starting HTTP server on :%d", port)
 	return http.ListenAndServe(fmt.Sprintf(":%d", port), nil)
 }
+
+// queryHost lists containers on backend and wraps the result (or error)
+// with its host label for /status.
+func queryHost(ctx context.Context, host string, backend docker.Backend) hostContainers {
+	containers, err := backend.ContainerList(ctx, types.ContainerListOptions{})
+	if err != nil {
+		return hostContainers{Host: host, Error: err.Error()}
+	}
+	return hostContainers{Host: host, Containers: containers}
+}
+
+// writeError maps an errdefs-classified error to the matching HTTP status
+// code; anything unclassified falls back to 500.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errdefs.IsNotFound(err):
+		status = http.StatusNotFound
+	case errdefs.IsInvalidParameter(err):
+		status = http.StatusBadRequest
+	case errdefs.IsUnauthorized(err):
+		status = http.StatusUnauthorized
+	case errdefs.IsConflict(err):
+		status = http.StatusConflict
+	case errdefs.IsUnavailable(err):
+		status = http.StatusServiceUnavailable
+	}
+	http.Error(w, err.Error(), status)
+}
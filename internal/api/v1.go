@@ -0,0 +1,157 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+
+	"Docker_Container_monitor/internal/docker"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
+)
+
+// handleContainers serves GET /v1/containers, optionally narrowed by
+// repeated "filter" query parameters (same syntax as --filter).
+func handleContainers(backend docker.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		containers, err := selector.Select(r.Context(), backend, r.URL.Query()["filter"], nil)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, containers)
+	}
+}
+
+// handleStats serves GET /v1/stats, optionally narrowed the same way
+// /v1/containers is.
+func handleStats(backend docker.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		matcher, err := filter.Parse(r.URL.Query()["filter"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		stats, err := docker.CollectStats(r.Context(), backend, matcher)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		writeJSON(w, stats)
+	}
+}
+
+// handleServices serves GET /v1/services.
+func handleServices(backend docker.Backend, threshold time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		results, err := docker.CollectServiceChecks(r.Context(), backend, threshold)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		writeJSON(w, results)
+	}
+}
+
+// handleEvents serves GET /v1/events as a Server-Sent Events stream,
+// accepting the same "since"/"until"/"filter" query parameters that
+// "monitor events" accepts as flags.
+func handleEvents(backend docker.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		since, err := docker.NormalizeEventTimestamp(r.URL.Query().Get("since"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid since: %v", err), http.StatusBadRequest)
+			return
+		}
+		until, err := docker.NormalizeEventTimestamp(r.URL.Query().Get("until"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid until: %v", err), http.StatusBadRequest)
+			return
+		}
+		filterArgs, err := docker.BuildEventFilterArgs(r.URL.Query()["filter"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		msgChan, errChan := backend.Events(r.Context(), types.EventsOptions{Since: since, Until: until, Filters: filterArgs})
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case event := <-msgChan:
+				data, err := json.Marshal(event)
+				if err != nil {
+					return
+				}
+				fmt.Fprintf(w, "data: %s\n\n", data)
+				flusher.Flush()
+			case err := <-errChan:
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", err)
+				flusher.Flush()
+				return
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
+
+// handleLogs serves GET /v1/logs/{name} as a chunked stream, honoring the
+// same "tail" and "follow" query parameters as "monitor logs" accepts as
+// flags.
+func handleLogs(backend docker.Backend) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/v1/logs/")
+		if name == "" {
+			http.Error(w, "container name required", http.StatusBadRequest)
+			return
+		}
+
+		tail := r.URL.Query().Get("tail")
+		if tail == "" {
+			tail = "100"
+		}
+
+		out, err := backend.ContainerLogs(r.Context(), name, types.ContainerLogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Follow:     r.URL.Query().Get("follow") == "true",
+			Tail:       tail,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer out.Close()
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fw := flushWriter{w: w}
+		if flusher, ok := w.(http.Flusher); ok {
+			fw.f = flusher
+		}
+		stdcopy.StdCopy(fw, fw, out)
+	}
+}
+
+// writeJSON marshals v as the response body with the matching Content-Type.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/client"
+)
+
+// Backend is the subset of the Docker Engine API client that the docker
+// package depends on. Production code gets it from NewBackend, tests can
+// supply a fake.
+type Backend interface {
+	ContainerList(ctx context.Context, options types.ContainerListOptions) ([]types.Container, error)
+	ContainerStats(ctx context.Context, containerID string, stream bool) (types.ContainerStats, error)
+	ContainerLogs(ctx context.Context, containerID string, options types.ContainerLogsOptions) (io.ReadCloser, error)
+	ContainerTop(ctx context.Context, containerID string, arguments []string) (container.ContainerTopOKBody, error)
+	ContainerInspect(ctx context.Context, containerID string) (types.ContainerJSON, error)
+	Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error)
+	// ContainerExec runs cmd inside containerID to completion and reports
+	// its exit code, the same signal Docker's own HEALTHCHECK uses.
+	ContainerExec(ctx context.Context, containerID string, cmd []string) (exitCode int, err error)
+	Close() error
+}
+
+// engineBackend adapts *client.Client to Backend, composing the
+// multi-step exec create/start/inspect dance into a single call.
+type engineBackend struct {
+	*client.Client
+}
+
+// NewBackend builds a Backend backed by the real Docker Engine API,
+// honoring DOCKER_HOST/DOCKER_TLS_VERIFY/DOCKER_CERT_PATH from the
+// environment and negotiating the API version with the daemon.
+func NewBackend() (Backend, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+	return WrapClient(cli), nil
+}
+
+// WrapClient adapts an already-constructed *client.Client (e.g. one
+// dialed over a tunneled connection) into a Backend.
+func WrapClient(cli *client.Client) Backend {
+	return engineBackend{cli}
+}
+
+func (b engineBackend) ContainerExec(ctx context.Context, containerID string, cmd []string) (int, error) {
+	created, err := b.ContainerExecCreate(ctx, containerID, types.ExecConfig{Cmd: cmd})
+	if err != nil {
+		return 0, err
+	}
+	if err := b.ContainerExecStart(ctx, created.ID, types.ExecStartCheck{}); err != nil {
+		return 0, err
+	}
+	for {
+		inspect, err := b.ContainerExecInspect(ctx, created.ID)
+		if err != nil {
+			return 0, err
+		}
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
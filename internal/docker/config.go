@@ -0,0 +1,47 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PsConfig is the on-disk config StateCmd consults for a default --format,
+// matching how `docker`'s own CLI reads `PsFormat` from its config file.
+type PsConfig struct {
+	PsFormat string `yaml:"PsFormat"`
+}
+
+// psConfigPath is a var so tests can point it elsewhere.
+var psConfigPath = func() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".docker_monitor.yaml"), nil
+}
+
+// loadPsConfig reads ~/.docker_monitor.yaml. A missing file is not an
+// error (there's simply no configured default); a malformed one is.
+func loadPsConfig() (*PsConfig, error) {
+	path, err := psConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &PsConfig{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %v", path, err)
+	}
+
+	var cfg PsConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", path, err)
+	}
+	return &cfg, nil
+}
@@ -1,20 +1,146 @@
 package docker
 
 import (
-	"os"
-	"os/exec"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
 )
 
-// EventsCmd streams Docker events
+const maxEventsBackoff = 30 * time.Second
+
+// EventsCmd streams Docker events, optionally bounded by --since/--until
+// and narrowed by repeatable --filter clauses. On a transient stream error
+// (the daemon restarting, a dropped connection) it reconnects with
+// exponential backoff instead of exiting, so a long-running `monitor
+// events` session survives daemon restarts.
 func EventsCmd(c *cli.Context) error {
-	args := []string{"events"}
-	if c.Bool("json") {
-		args = []string{"events", "--format", "{{json .}}"}
-	}
-	cmd := exec.Command("docker", args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	since, err := NormalizeEventTimestamp(c.String("since"))
+	if err != nil {
+		return errdefs.InvalidParameter(fmt.Errorf("invalid --since: %v", err))
+	}
+	until, err := NormalizeEventTimestamp(c.String("until"))
+	if err != nil {
+		return errdefs.InvalidParameter(fmt.Errorf("invalid --until: %v", err))
+	}
+	filterArgs, err := BuildEventFilterArgs(c.StringSlice("filter"))
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+	options := types.EventsOptions{Since: since, Until: until, Filters: filterArgs}
+
+	useJSON := c.Bool("json")
+	backoff := time.Second
+
+	for {
+		backend, err := newBackend()
+		if err != nil {
+			return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+		}
+
+		ctx := c.Context
+		msgChan, errChan := backend.Events(ctx, options)
+		streamErr := consumeEvents(ctx, msgChan, errChan, useJSON)
+		backend.Close()
+
+		if c.Context.Err() != nil {
+			return c.Context.Err()
+		}
+		if !isRetryableEventsError(streamErr) {
+			return errdefs.Unavailable(fmt.Errorf("event stream error: %v", streamErr))
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-c.Context.Done():
+			return c.Context.Err()
+		}
+		backoff *= 2
+		if backoff > maxEventsBackoff {
+			backoff = maxEventsBackoff
+		}
+	}
+}
+
+// consumeEvents prints events from msgChan until errChan yields an error or
+// ctx is done.
+func consumeEvents(ctx context.Context, msgChan <-chan events.Message, errChan <-chan error, useJSON bool) error {
+	for {
+		select {
+		case event := <-msgChan:
+			if useJSON {
+				b, err := json.Marshal(event)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(b))
+			} else {
+				fmt.Printf("%s %s %s %v\n", event.Type, event.Action, event.Actor.ID, event.Actor.Attributes)
+			}
+		case err := <-errChan:
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// isRetryableEventsError reports whether an Events() stream error looks
+// transient (the stream ending, a dropped connection) rather than a
+// permanent misconfiguration.
+func isRetryableEventsError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// NormalizeEventTimestamp validates ts as either Unix seconds or RFC3339,
+// the two formats the Engine API accepts for EventsOptions.Since/Until.
+// It's exported so the HTTP API's /v1/events can accept the same
+// "since"/"until" query parameters that EventsCmd accepts as flags.
+func NormalizeEventTimestamp(ts string) (string, error) {
+	if ts == "" {
+		return "", nil
+	}
+	if _, err := strconv.ParseInt(ts, 10, 64); err == nil {
+		return ts, nil
+	}
+	if _, err := time.Parse(time.RFC3339, ts); err == nil {
+		return ts, nil
+	}
+	return "", fmt.Errorf("expected RFC3339 or Unix seconds, got %q", ts)
+}
+
+// BuildEventFilterArgs translates repeatable "key=value" --filter clauses
+// (e.g. "type=container", "event=die", "label=foo=bar") into filters.Args
+// for EventsOptions. It's exported for the same reason as
+// NormalizeEventTimestamp: /v1/events takes the same clauses as a
+// repeated "filter" query parameter.
+func BuildEventFilterArgs(clauses []string) (filters.Args, error) {
+	args := filters.NewArgs()
+	for _, clause := range clauses {
+		parts := strings.SplitN(clause, "=", 2)
+		if len(parts) != 2 {
+			return args, fmt.Errorf("invalid filter %q: expected key=value", clause)
+		}
+		args.Add(parts[0], parts[1])
+	}
+	return args, nil
 }
@@ -0,0 +1,68 @@
+package docker
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+)
+
+func TestNormalizeEventTimestamp(t *testing.T) {
+	cases := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"", false},
+		{"1700000000", false},
+		{"2024-01-01T00:00:00Z", false},
+		{"not-a-timestamp", true},
+	}
+	for _, c := range cases {
+		got, err := NormalizeEventTimestamp(c.in)
+		if c.wantErr && err == nil {
+			t.Errorf("NormalizeEventTimestamp(%q): expected an error", c.in)
+		}
+		if !c.wantErr && err != nil {
+			t.Errorf("NormalizeEventTimestamp(%q): unexpected error: %v", c.in, err)
+		}
+		if !c.wantErr && got != c.in {
+			t.Errorf("NormalizeEventTimestamp(%q) = %q, want unchanged", c.in, got)
+		}
+	}
+}
+
+func TestBuildEventFilterArgs(t *testing.T) {
+	args, err := BuildEventFilterArgs([]string{"type=container", "label=foo=bar"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !args.Contains("type") || !args.Contains("label") {
+		t.Fatalf("expected type and label filters, got %v", args)
+	}
+
+	if _, err := BuildEventFilterArgs([]string{"malformed"}); err == nil {
+		t.Fatal("expected an error for a clause with no '='")
+	}
+}
+
+type fakeNetError struct{}
+
+func (fakeNetError) Error() string   { return "fake net error" }
+func (fakeNetError) Timeout() bool   { return true }
+func (fakeNetError) Temporary() bool { return true }
+
+func TestIsRetryableEventsError(t *testing.T) {
+	if isRetryableEventsError(nil) {
+		t.Error("expected nil to not be retryable")
+	}
+	if !isRetryableEventsError(io.EOF) {
+		t.Error("expected io.EOF to be retryable")
+	}
+	var netErr net.Error = fakeNetError{}
+	if !isRetryableEventsError(netErr) {
+		t.Error("expected a net.Error to be retryable")
+	}
+	if isRetryableEventsError(errors.New("permanent")) {
+		t.Error("expected a plain error to not be retryable")
+	}
+}
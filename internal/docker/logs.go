@@ -3,25 +3,80 @@ package docker
 import (
 	"fmt"
 	"os"
-	"os/exec"
+	"strings"
 
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
 )
 
-// LogsCmd tails or follows logs of a container
+// LogsCmd tails or follows logs of a container, named either positionally
+// or, if omitted, by --filter (which must narrow the fleet to exactly one
+// container).
 func LogsCmd(c *cli.Context) error {
-	if c.Args().Len() < 1 {
-		return fmt.Errorf("provide container name")
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
+		return nil
+	}
+
+	backend, err := newBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+	defer backend.Close()
+
+	name, err := resolveLogsTarget(c, backend)
+	if err != nil {
+		return err
 	}
-	name := c.Args().Get(0)
-	args := []string{"logs", "--tail", fmt.Sprint(c.Int("tail"))}
-	if c.Bool("follow") {
-		args = append(args, "-f")
+
+	out, err := backend.ContainerLogs(c.Context, name, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     c.Bool("follow"),
+		Tail:       fmt.Sprint(c.Int("tail")),
+	})
+	if err != nil {
+		return fmt.Errorf("container logs failed: %v", err)
 	}
-	args = append(args, name)
+	defer out.Close()
+
+	_, err = stdcopy.StdCopy(os.Stdout, os.Stderr, out)
+	return err
+}
 
-	cmd := exec.CommandContext(c.Context, "docker", args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+// resolveLogsTarget returns the container name LogsCmd should tail: the
+// first positional argument if given, otherwise the single container
+// matching --filter. It errors if --filter matches zero or more than one
+// container, since LogsCmd only streams one container's logs.
+func resolveLogsTarget(c *cli.Context, backend Backend) (string, error) {
+	if c.Args().Len() > 0 {
+		return c.Args().Get(0), nil
+	}
+
+	if len(c.StringSlice("filter")) == 0 {
+		return "", errdefs.InvalidParameter(fmt.Errorf("provide a container name or --filter"))
+	}
+
+	containers, err := selector.Select(c.Context, backend, c.StringSlice("filter"), nil)
+	if err != nil {
+		return "", errdefs.InvalidParameter(err)
+	}
+
+	switch len(containers) {
+	case 0:
+		return "", errdefs.NotFound(fmt.Errorf("--filter matched no containers"))
+	case 1:
+		return strings.TrimPrefix(containers[0].Names[0], "/"), nil
+	default:
+		names := make([]string, len(containers))
+		for i, cont := range containers {
+			names[i] = strings.TrimPrefix(cont.Names[0], "/")
+		}
+		return "", errdefs.InvalidParameter(fmt.Errorf("--filter matched %d containers (%s), narrow it to one", len(containers), strings.Join(names, ", ")))
+	}
 }
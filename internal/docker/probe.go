@@ -0,0 +1,226 @@
+package docker
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe checks whether a single published container port is healthy.
+// Concrete implementations assume different things about what's behind
+// the port, so ServiceCmd picks one per-container based on container
+// labels instead of always speaking HTTP.
+//
+// This is the one tcp/http/https/tls/grpc/exec probe implementation:
+// ServiceCmd, the HTTP API's /v1/services (internal/api/v1.go) and the
+// exporter's monitor_service_probe_up gauge
+// (internal/metrics/probe_collector.go) all run it.
+type Probe interface {
+	// Name identifies the probe, and is stored on ServiceCheckResult.Probe.
+	Name() string
+	// Check reaches target and returns a human-readable status such as
+	// "available", "unreachable" or "HTTP 500".
+	Check(ctx context.Context, target ProbeTarget) (status string, err error)
+}
+
+// ProbeTarget is everything a Probe needs to reach one published port.
+type ProbeTarget struct {
+	ContainerID   string
+	ContainerName string
+	Host          string
+	Port          string
+	Labels        map[string]string
+}
+
+const (
+	labelProbe       = "monitor.probe"
+	labelProbePath   = "monitor.probe.path"
+	labelProbeExpect = "monitor.probe.expect"
+	labelProbeExec   = "monitor.probe.exec"
+)
+
+// probeFor selects a Probe for cont based on its monitor.probe label,
+// defaulting to a plain TCP connect when the label is absent or unknown.
+func probeFor(cont types.Container, backend Backend) Probe {
+	switch strings.ToLower(cont.Labels[labelProbe]) {
+	case "http":
+		return newHTTPProbe(cont.Labels, false)
+	case "https":
+		return newHTTPProbe(cont.Labels, true)
+	case "tls":
+		return tlsProbe{}
+	case "grpc":
+		return grpcProbe{}
+	case "exec":
+		return execProbe{backend: backend, cmd: cont.Labels[labelProbeExec]}
+	default:
+		return tcpProbe{}
+	}
+}
+
+// tcpProbe reports a port healthy if a TCP connection can be opened to
+// it at all. It's the default when a container carries no monitor.probe
+// label.
+type tcpProbe struct{}
+
+func (tcpProbe) Name() string { return "tcp" }
+
+func (tcpProbe) Check(ctx context.Context, target ProbeTarget) (string, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(target.Host, target.Port))
+	if err != nil {
+		return "unreachable", nil
+	}
+	conn.Close()
+	return "available", nil
+}
+
+// httpProbe issues a GET against a configurable path and accepts a
+// configurable set of response codes, defaulting to "/" and 200.
+type httpProbe struct {
+	scheme  string
+	path    string
+	expect  map[int]bool
+	timeout time.Duration
+}
+
+func newHTTPProbe(labels map[string]string, tlsEnabled bool) httpProbe {
+	p := httpProbe{
+		scheme:  "http",
+		path:    "/",
+		expect:  map[int]bool{200: true},
+		timeout: 5 * time.Second,
+	}
+	if tlsEnabled {
+		p.scheme = "https"
+	}
+	if v := labels[labelProbePath]; v != "" {
+		p.path = v
+	}
+	if v := labels[labelProbeExpect]; v != "" {
+		p.expect = make(map[int]bool)
+		for _, code := range strings.Split(v, ",") {
+			if n, err := strconv.Atoi(strings.TrimSpace(code)); err == nil {
+				p.expect[n] = true
+			}
+		}
+	}
+	return p
+}
+
+func (p httpProbe) Name() string { return p.scheme }
+
+func (p httpProbe) Check(ctx context.Context, target ProbeTarget) (string, error) {
+	url := fmt.Sprintf("%s://%s:%s%s", p.scheme, target.Host, target.Port, p.path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "request error", nil
+	}
+	client := &http.Client{
+		Timeout: p.timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "unreachable", nil
+	}
+	defer resp.Body.Close()
+	if p.expect[resp.StatusCode] {
+		return "available", nil
+	}
+	return fmt.Sprintf("HTTP %d", resp.StatusCode), nil
+}
+
+// tlsProbe completes a TLS handshake and flags certificates that have
+// already expired or are about to.
+type tlsProbe struct{}
+
+func (tlsProbe) Name() string { return "tls" }
+
+func (tlsProbe) Check(ctx context.Context, target ProbeTarget) (string, error) {
+	d := &net.Dialer{}
+	conn, err := tls.DialWithDialer(d, "tcp", net.JoinHostPort(target.Host, target.Port), &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return "unreachable", nil
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "available", nil
+	}
+	expiry := certs[0].NotAfter
+	switch {
+	case time.Now().After(expiry):
+		return fmt.Sprintf("certificate expired %s", expiry.Format("2006-01-02")), nil
+	case time.Until(expiry) < 14*24*time.Hour:
+		return fmt.Sprintf("certificate expires %s", expiry.Format("2006-01-02")), nil
+	default:
+		return "available", nil
+	}
+}
+
+// grpcProbe calls the standard grpc.health.v1 Health/Check RPC.
+type grpcProbe struct{}
+
+func (grpcProbe) Name() string { return "grpc" }
+
+func (grpcProbe) Check(ctx context.Context, target ProbeTarget) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.NewClient(net.JoinHostPort(target.Host, target.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return "unreachable", nil
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return "unreachable", nil
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return resp.Status.String(), nil
+	}
+	return "available", nil
+}
+
+// execProbe runs a command inside the container via "docker exec" and
+// treats a zero exit code as healthy, the same signal Docker's own
+// HEALTHCHECK instruction uses.
+type execProbe struct {
+	backend Backend
+	cmd     string
+}
+
+func (execProbe) Name() string { return "exec" }
+
+func (p execProbe) Check(ctx context.Context, target ProbeTarget) (string, error) {
+	if p.cmd == "" {
+		return "no monitor.probe.exec command configured", nil
+	}
+	if p.backend == nil {
+		return "unreachable", nil
+	}
+
+	exitCode, err := p.backend.ContainerExec(ctx, target.ContainerID, strings.Fields(p.cmd))
+	if err != nil {
+		return "unreachable", nil
+	}
+	if exitCode == 0 {
+		return "available", nil
+	}
+	return fmt.Sprintf("exit %d", exitCode), nil
+}
@@ -1,75 +1,49 @@
 package docker
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
-	"os/exec"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/docker/docker/api/types"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
 )
 
 type ServiceCheckResult struct {
-	Container string `json:"container"`
-	Port      string `json:"port"`
-	Status    string `json:"status"`
+	Container string  `json:"container"`
+	Port      string  `json:"port"`
+	Status    string  `json:"status"`
+	Probe     string  `json:"probe"`
+	LatencyMs float64 `json:"latency_ms"`
 }
 
-// ServiceCmd performs HTTP checks and shows a table
+// ServiceCmd checks every exposed container port with a Probe selected by
+// that container's monitor.probe label (TCP-connect by default) and
+// shows the results in a table.
 func ServiceCmd(c *cli.Context) error {
 	threshold := c.Duration("threshold")
 	webhook := c.String("alert")
 
-	out, err := exec.Command("docker", "ps", "--format", "{{.Names}}: {{.Ports}}").CombinedOutput()
+	backend, err := newBackend()
 	if err != nil {
-		return fmt.Errorf("docker ps failed: %v\n%s", err, out)
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
 	}
-	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
-
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	var result []ServiceCheckResult
+	defer backend.Close()
 
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		parts := strings.SplitN(line, ": ", 2)
-		if len(parts) != 2 {
-			continue
-		}
-		name, portsStr := parts[0], parts[1]
-		for _, p := range strings.Split(portsStr, ", ") {
-			hostPort := strings.SplitN(p, "->", 2)[0]
-			port := strings.Split(hostPort, ":")[1]
-			url := fmt.Sprintf("http://localhost:%s", port)
-			wg.Add(1)
-			go func(container, port, url string) {
-				defer wg.Done()
-				start := time.Now()
-				resp, err := http.Get(url)
-				status := "unreachable"
-				if err == nil {
-					if resp.StatusCode == 200 && time.Since(start) < threshold {
-						status = "available"
-					} else {
-						status = fmt.Sprintf("%s (%.0fms)", resp.Status, time.Since(start).Seconds()*1000)
-					}
-					resp.Body.Close()
-				}
-				mu.Lock()
-				result = append(result, ServiceCheckResult{container, port, status})
-				mu.Unlock()
-			}(name, port, url)
-		}
+	result, err := CollectServiceChecks(c.Context, backend, threshold)
+	if err != nil {
+		return errdefs.Unavailable(err)
 	}
-	wg.Wait()
 
 	if c.Bool("json") {
 		b, _ := json.Marshal(result)
@@ -78,7 +52,7 @@ func ServiceCmd(c *cli.Context) error {
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Container", "Port", "Status"})
+	table.SetHeader([]string{"Container", "Port", "Probe", "Status"})
 	table.SetCaption(true, color.MagentaString("Service checks"))
 
 	for _, r := range result {
@@ -91,13 +65,68 @@ func ServiceCmd(c *cli.Context) error {
 		default:
 			s = color.YellowString("⚠️ %s", r.Status)
 		}
-		table.Append([]string{r.Container, r.Port, s})
+		table.Append([]string{r.Container, r.Port, r.Probe, s})
 		if webhook != "" && r.Status != "available" {
 			// fire webhook
 			http.Post(webhook, "application/json",
-				strings.NewReader(fmt.Sprintf(`{"container":"%s","port":"%s","status":"%s"}`, r.Container, r.Port, r.Status)))
+				strings.NewReader(fmt.Sprintf(`{"container":"%s","port":"%s","probe":"%s","status":"%s"}`, r.Container, r.Port, r.Probe, r.Status)))
 		}
 	}
 	table.Render()
 	return nil
 }
+
+// CollectServiceChecks probes every exposed port of every container with
+// that container's selected Probe, concurrently. It's the shared core
+// behind both ServiceCmd and the HTTP API's /v1/services endpoint.
+func CollectServiceChecks(ctx context.Context, backend Backend, threshold time.Duration) ([]ServiceCheckResult, error) {
+	containers, err := backend.ContainerList(ctx, types.ContainerListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("container list failed: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var result []ServiceCheckResult
+
+	for _, cont := range containers {
+		name := strings.TrimPrefix(cont.Names[0], "/")
+		probe := probeFor(cont, backend)
+		for _, p := range cont.Ports {
+			if p.PublicPort == 0 {
+				continue
+			}
+			port := strconv.Itoa(int(p.PublicPort))
+			target := ProbeTarget{
+				ContainerID:   cont.ID,
+				ContainerName: name,
+				Host:          "localhost",
+				Port:          port,
+				Labels:        cont.Labels,
+			}
+			wg.Add(1)
+			go func(container string, target ProbeTarget, probe Probe) {
+				defer wg.Done()
+				start := time.Now()
+				status, err := probe.Check(ctx, target)
+				latency := time.Since(start)
+				if err != nil {
+					status = "unreachable"
+				} else if status == "available" && latency >= threshold {
+					status = fmt.Sprintf("slow (%.0fms)", latency.Seconds()*1000)
+				}
+				mu.Lock()
+				result = append(result, ServiceCheckResult{
+					Container: container,
+					Port:      target.Port,
+					Status:    status,
+					Probe:     probe.Name(),
+					LatencyMs: latency.Seconds() * 1000,
+				})
+				mu.Unlock()
+			}(name, target, probe)
+		}
+	}
+	wg.Wait()
+	return result, nil
+}
@@ -1,58 +1,185 @@
 package docker
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
+	"regexp"
 	"strings"
+	"text/template"
 
+	"github.com/docker/docker/api/types"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
 )
 
+// newBackend is a var so tests can inject a fake Backend.
+var newBackend = NewBackend
+
 // StateCmd lists containers with colored statuses
 func StateCmd(c *cli.Context) error {
-	args := []string{"ps", "--format", "{{.Names}}|{{.Status}}"}
-	if c.Bool("json") {
-		out, err := exec.Command("docker", "ps", "--format", "{{json .}}").Output()
-		if err != nil {
-			return fmt.Errorf("docker ps failed: %v", err)
-		}
-		// wrap lines into JSON array
-		arr := strings.Split(strings.TrimSpace(string(out)), "\n")
-		fmt.Println("[" + strings.Join(arr, ",") + "]")
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
 		return nil
 	}
 
-	out, err := exec.Command("docker", args...).CombinedOutput()
+	if c.Bool("watch") {
+		return WatchCmd(c)
+	}
+
+	backend, err := newBackend()
 	if err != nil {
-		return fmt.Errorf("docker ps failed: %v\n%s", err, out)
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
 	}
-	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
-	if len(lines) == 0 || lines[0] == "" {
-		color.Yellow("⚠️  No running containers")
+	defer backend.Close()
+
+	containers, err := selector.Select(c.Context, backend, c.StringSlice("filter"), c.Args().Slice())
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	format := c.String("format")
+	if format == "" && c.Bool("json") {
+		format = "json"
+	}
+	if format == "" {
+		cfg, err := loadPsConfig()
+		if err != nil {
+			return errdefs.InvalidParameter(err)
+		}
+		format = cfg.PsFormat
+	}
+	if format == "" {
+		format = "table"
+	}
+
+	return renderState(os.Stdout, containers, format)
+}
+
+// renderState dispatches a --format value (or PsFormat default) to the
+// matching renderer: the reserved "table" and "json" values, "table
+// TEMPLATE" for a custom-column table, or a bare Go text/template string
+// executed once per container.
+func renderState(w *os.File, containers []types.Container, format string) error {
+	switch {
+	case format == "table":
+		renderStateTable(containers)
+		return nil
+	case format == "json":
+		b, err := json.Marshal(containers)
+		if err != nil {
+			return fmt.Errorf("json marshal error: %v", err)
+		}
+		fmt.Fprintln(w, string(b))
 		return nil
+	case strings.HasPrefix(format, "table "):
+		return renderStateCustomTable(w, containers, strings.TrimPrefix(format, "table "))
+	default:
+		return renderStateFormat(w, containers, format)
+	}
+}
+
+// renderStateTable prints containers as the colored table StateCmd and
+// WatchCmd both show.
+func renderStateTable(containers []types.Container) {
+	if len(containers) == 0 {
+		color.Yellow("⚠️  No running containers")
+		return
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"📦 Container", "🔹 Status"})
 	table.SetCaption(true, color.GreenString("Containers"))
 
-	for _, line := range lines {
-		parts := strings.SplitN(line, "|", 2)
-		name, status := parts[0], parts[1]
+	for _, cont := range containers {
+		name := strings.TrimPrefix(cont.Names[0], "/")
 		var s string
 		switch {
-		case strings.HasPrefix(status, "Up"):
-			s = color.GreenString("✔️  %s", status)
-		case strings.HasPrefix(status, "Exited"):
-			s = color.RedString("❌  %s", status)
+		case strings.HasPrefix(cont.Status, "Up"):
+			s = color.GreenString("✔️  %s", cont.Status)
+		case strings.HasPrefix(cont.Status, "Exited"):
+			s = color.RedString("❌  %s", cont.Status)
 		default:
-			s = color.YellowString("⚠️  %s", status)
+			s = color.YellowString("⚠️  %s", cont.Status)
 		}
 		table.Append([]string{name, s})
 	}
 	table.Render()
+}
+
+// templateFuncs are the convenience funcs available to every --format
+// template, mirroring the ones the Docker CLI's own --format supports.
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"truncate": func(s string, n int) string {
+		if len(s) <= n {
+			return s
+		}
+		return s[:n]
+	},
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// renderStateFormat executes a Go text/template format string against each
+// container in turn, one line per container (a la `docker ps --format`).
+// Template fields are those of types.Container, e.g.
+// "{{.ID}} {{index .Names 0}} {{.Status}}".
+func renderStateFormat(w *os.File, containers []types.Container, format string) error {
+	tmpl, err := template.New("state").Funcs(templateFuncs).Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %v", err)
+	}
+	for _, cont := range containers {
+		if err := tmpl.Execute(w, cont); err != nil {
+			return fmt.Errorf("--format template error: %v", err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// templateFieldRE pulls the field names referenced by a template, e.g.
+// "{{.Status}}" -> "Status", so renderStateCustomTable can turn them into
+// column headers.
+var templateFieldRE = regexp.MustCompile(`{{\s*\.(\w+)\s*}}`)
+
+// renderStateCustomTable renders a "table TEMPLATE" format: TEMPLATE's
+// referenced fields become the tablewriter headers, and TEMPLATE itself is
+// executed once per container to fill each row.
+func renderStateCustomTable(w *os.File, containers []types.Container, format string) error {
+	fields := templateFieldRE.FindAllStringSubmatch(format, -1)
+	if len(fields) == 0 {
+		return fmt.Errorf("invalid table template %q: no {{.Field}} references found", format)
+	}
+	headers := make([]string, len(fields))
+	for i, f := range fields {
+		headers[i] = f[1]
+	}
+
+	tmpl, err := template.New("state").Funcs(templateFuncs).Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %v", err)
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetHeader(headers)
+	var buf strings.Builder
+	for _, cont := range containers {
+		buf.Reset()
+		if err := tmpl.Execute(&buf, cont); err != nil {
+			return fmt.Errorf("--format template error: %v", err)
+		}
+		table.Append(strings.Split(buf.String(), "\t"))
+	}
+	table.Render()
 	return nil
 }
@@ -0,0 +1,81 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+)
+
+func containersFixture() []types.Container {
+	return []types.Container{
+		{ID: "abc123", Names: []string{"/web"}, Status: "Up 2 hours"},
+		{ID: "def456", Names: []string{"/db"}, Status: "Exited (1) 5 minutes ago"},
+	}
+}
+
+func TestRenderStateJSON(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	if err := renderState(w, containersFixture(), "json"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	var got []types.Container
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output isn't valid JSON: %v (%s)", err, buf.String())
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 containers, got %d", len(got))
+	}
+}
+
+func TestRenderStateCustomTable(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	if err := renderStateCustomTable(w, containersFixture(), "{{.ID}}\t{{.Status}}"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	out := buf.String()
+	if !strings.Contains(out, "abc123") || !strings.Contains(out, "Up 2 hours") {
+		t.Fatalf("expected container fields in custom table output, got:\n%s", out)
+	}
+}
+
+func TestRenderStateFormatUsesTemplateFuncs(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	if err := renderStateFormat(w, containersFixture(), "{{upper .Status}}"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Close()
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	if !strings.Contains(buf.String(), "UP 2 HOURS") {
+		t.Fatalf("expected the upper func to uppercase Status, got:\n%s", buf.String())
+	}
+}
+
+func TestRenderStateCustomTableRejectsFieldlessTemplate(t *testing.T) {
+	if err := renderStateCustomTable(os.Stdout, containersFixture(), "no fields here"); err == nil {
+		t.Fatal("expected an error for a table template with no {{.Field}} references")
+	}
+}
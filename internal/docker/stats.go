@@ -1,53 +1,161 @@
 package docker
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 
+	"github.com/docker/docker/api/types"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/pkg/filter"
 )
 
+type containerStat struct {
+	Name    string
+	CPUPerc string
+	MemUsed string
+	BlockIO string
+	NetIO   string
+}
+
 // StatsCmd shows container stats in a table
 func StatsCmd(c *cli.Context) error {
-	rawArgs := []string{"stats", "--no-stream", "--format", "{{json .}}"}
-	prettyArgs := []string{"stats", "--no-stream", "--format",
-		"⚙️ {{.Name}}|{{.CPUPerc}}|🧠 {{.MemUsage}}|💾 {{.BlockIO}}|🌐 {{.NetIO}}"}
-	args := prettyArgs
-	if c.Bool("json") {
-		args = rawArgs
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
+		return nil
+	}
+
+	matcher, err := filter.Parse(c.StringSlice("filter"))
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	backend, err := newBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
 	}
-	args = append(args, c.Args().Slice()...)
+	defer backend.Close()
 
-	out, err := exec.Command("docker", args...).CombinedOutput()
+	stats, err := CollectStats(c.Context, backend, matcher)
 	if err != nil {
-		return fmt.Errorf("docker stats failed: %v\n%s", err, out)
+		return errdefs.Unavailable(err)
+	}
+
+	names := c.Args().Slice()
+	if len(names) > 0 {
+		wanted := make(map[string]bool, len(names))
+		for _, n := range names {
+			wanted[n] = true
+		}
+		narrowed := stats[:0]
+		for _, s := range stats {
+			if wanted[s.Name] {
+				narrowed = append(narrowed, s)
+			}
+		}
+		stats = narrowed
 	}
 
-	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
 	if c.Bool("json") {
-		fmt.Println("[" + strings.Join(lines, ",") + "]")
+		b, err := json.Marshal(stats)
+		if err != nil {
+			return fmt.Errorf("json marshal error: %v", err)
+		}
+		fmt.Println(string(b))
 		return nil
 	}
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"Container", "CPU %", "Memory", "Disk I/O", "Net I/O"})
 	table.SetCaption(true, color.CyanString("Live stats"))
+	for _, s := range stats {
+		table.Append([]string{s.Name, s.CPUPerc, s.MemUsed, s.BlockIO, s.NetIO})
+	}
+	table.Render()
+	return nil
+}
+
+// CollectStats gathers a CPU/memory/network/disk snapshot for every
+// container matching matcher, scraping ContainerStats and computing CPU
+// percent the same way "docker stats" does. It's the shared core behind
+// both StatsCmd and the HTTP API's /v1/stats endpoint.
+func CollectStats(ctx context.Context, backend Backend, matcher *filter.Matcher) ([]containerStat, error) {
+	containers, err := backend.ContainerList(ctx, types.ContainerListOptions{Filters: matcher.Args})
+	if err != nil {
+		return nil, fmt.Errorf("container list failed: %v", err)
+	}
 
-	for _, ln := range lines {
-		parts := strings.Split(ln, "|")
-		if len(parts) != 5 {
+	var stats []containerStat
+	for _, cont := range containers {
+		name := strings.TrimPrefix(cont.Names[0], "/")
+
+		resp, err := backend.ContainerStats(ctx, cont.ID, false)
+		if err != nil {
+			color.Yellow("Warning: could not get stats for %s", name)
+			continue
+		}
+
+		var v types.StatsJSON
+		err = json.NewDecoder(resp.Body).Decode(&v)
+		resp.Body.Close()
+		if err != nil {
 			continue
 		}
-		table.Append([]string{
-			strings.TrimPrefix(parts[0], "⚙️ "), parts[1],
-			strings.TrimPrefix(parts[2], "🧠 "), strings.TrimPrefix(parts[3], "💾 "),
-			strings.TrimPrefix(parts[4], "🌐 "),
+
+		cpuPercent := filter.CalculateCPUPercent(&v)
+		if !matcher.Match(cont, cpuPercent) {
+			continue
+		}
+
+		stats = append(stats, containerStat{
+			Name:    name,
+			CPUPerc: fmt.Sprintf("%.2f%%", cpuPercent),
+			MemUsed: fmt.Sprintf("%s / %s", formatBytes(v.MemoryStats.Usage), formatBytes(v.MemoryStats.Limit)),
+			BlockIO: fmt.Sprintf("%s / %s", formatBytes(blkioTotal(v.BlkioStats.IoServiceBytesRecursive, "read")), formatBytes(blkioTotal(v.BlkioStats.IoServiceBytesRecursive, "write"))),
+			NetIO:   fmt.Sprintf("%s / %s", formatBytes(netTotal(v.Networks, "rx")), formatBytes(netTotal(v.Networks, "tx"))),
 		})
 	}
-	table.Render()
-	return nil
+	return stats, nil
+}
+
+func blkioTotal(entries []types.BlkioStatEntry, op string) uint64 {
+	var total uint64
+	for _, e := range entries {
+		if strings.EqualFold(e.Op, op) {
+			total += e.Value
+		}
+	}
+	return total
+}
+
+func netTotal(networks map[string]types.NetworkStats, dir string) uint64 {
+	var total uint64
+	for _, n := range networks {
+		if dir == "rx" {
+			total += n.RxBytes
+		} else {
+			total += n.TxBytes
+		}
+	}
+	return total
+}
+
+// formatBytes converts bytes to human-readable form, e.g. "12.3 MB".
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
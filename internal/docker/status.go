@@ -0,0 +1,206 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/docker/docker/api/types"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/sync/errgroup"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
+)
+
+// ContainerSummary is an enriched view of a container, combining the fields
+// ContainerList already returns with the ones only ContainerInspect knows
+// about (health, exit code, per-network IPs, published ports).
+type ContainerSummary struct {
+	ID       string
+	Name     string
+	State    string
+	Health   string
+	IPs      map[string]string
+	ExitCode int
+	Ports    []string
+}
+
+// StatusCmd reports a richer per-container summary than StateCmd: health,
+// exit code, per-network IPs and published ports. ContainerList supplies the
+// container set, then one ContainerInspect per container (run concurrently,
+// bounded by --concurrency) fills in the detail ContainerList doesn't carry.
+func StatusCmd(c *cli.Context) error {
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
+		return nil
+	}
+
+	backend, err := newBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+	defer backend.Close()
+
+	containers, err := selector.Select(c.Context, backend, c.StringSlice("filter"), c.Args().Slice())
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	summaries, err := inspectAll(c.Context, backend, containers, c.Int("concurrency"))
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("container inspect failed: %v", err))
+	}
+
+	if format := c.String("format"); format != "" {
+		return renderStatusFormat(os.Stdout, summaries, format)
+	}
+
+	if c.Bool("json") {
+		b, err := json.Marshal(summaries)
+		if err != nil {
+			return fmt.Errorf("json marshal error: %v", err)
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+
+	if len(summaries) == 0 {
+		color.Yellow("⚠️  No containers")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Container", "State", "Health", "IPs", "Ports"})
+	table.SetCaption(true, color.CyanString("Status"))
+	for _, s := range summaries {
+		table.Append([]string{s.Name, stateCell(s), healthCell(s.Health), strings.Join(summaryIPs(s), ", "), strings.Join(s.Ports, ", ")})
+	}
+	table.Render()
+	return nil
+}
+
+// defaultInspectConcurrency is used when --concurrency is unset or not a
+// positive number, since errgroup.Group.SetLimit(0) would otherwise block
+// every inspect forever on a zero-capacity semaphore.
+const defaultInspectConcurrency = 8
+
+// inspectAll runs ContainerInspect for every container concurrently,
+// bounded by concurrency (inspecting hundreds of containers serially is
+// slow), and returns one ContainerSummary per container in the same order
+// containers was given.
+func inspectAll(ctx context.Context, backend Backend, containers []types.Container, concurrency int) ([]ContainerSummary, error) {
+	if concurrency <= 0 {
+		concurrency = defaultInspectConcurrency
+	}
+	summaries := make([]ContainerSummary, len(containers))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for i, cont := range containers {
+		i, cont := i, cont
+		g.Go(func() error {
+			detail, err := backend.ContainerInspect(ctx, cont.ID)
+			if err != nil {
+				return fmt.Errorf("inspect %s: %v", strings.TrimPrefix(cont.Names[0], "/"), err)
+			}
+			summaries[i] = summarize(cont, detail)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// summarize combines a ps-style container with its inspect detail into a
+// ContainerSummary.
+func summarize(cont types.Container, detail types.ContainerJSON) ContainerSummary {
+	s := ContainerSummary{
+		ID:    cont.ID,
+		Name:  strings.TrimPrefix(cont.Names[0], "/"),
+		State: detail.State.Status,
+		IPs:   map[string]string{},
+	}
+	if detail.State.Status == "exited" || detail.State.Status == "dead" {
+		s.ExitCode = detail.State.ExitCode
+	}
+	if detail.State.Health != nil {
+		s.Health = detail.State.Health.Status
+	}
+	if detail.NetworkSettings != nil {
+		for network, endpoint := range detail.NetworkSettings.Networks {
+			if endpoint.IPAddress != "" {
+				s.IPs[network] = endpoint.IPAddress
+			}
+		}
+		for port, bindings := range detail.NetworkSettings.Ports {
+			for _, b := range bindings {
+				s.Ports = append(s.Ports, fmt.Sprintf("%s:%s->%s", b.HostIP, b.HostPort, port))
+			}
+		}
+		sort.Strings(s.Ports)
+	}
+	return s
+}
+
+// summaryIPs returns s.IPs rendered as "network=ip", sorted by network name
+// so table/JSON output is stable.
+func summaryIPs(s ContainerSummary) []string {
+	networks := make([]string, 0, len(s.IPs))
+	for network := range s.IPs {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+	ips := make([]string, len(networks))
+	for i, network := range networks {
+		ips[i] = fmt.Sprintf("%s=%s", network, s.IPs[network])
+	}
+	return ips
+}
+
+// stateCell renders the State column, surfacing the exit code for stopped
+// containers (e.g. "exited (137)").
+func stateCell(s ContainerSummary) string {
+	if s.ExitCode != 0 {
+		return fmt.Sprintf("%s (%d)", s.State, s.ExitCode)
+	}
+	return s.State
+}
+
+// healthCell colors unhealthy red and starting yellow, leaving healthy/none
+// uncolored.
+func healthCell(health string) string {
+	switch health {
+	case "unhealthy":
+		return color.RedString(health)
+	case "starting":
+		return color.YellowString(health)
+	default:
+		return health
+	}
+}
+
+// renderStatusFormat executes a Go text/template format string against each
+// summary in turn, one line per container, mirroring renderStateFormat.
+func renderStatusFormat(w *os.File, summaries []ContainerSummary, format string) error {
+	tmpl, err := template.New("status").Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %v", err)
+	}
+	for _, s := range summaries {
+		if err := tmpl.Execute(w, s); err != nil {
+			return fmt.Errorf("--format template error: %v", err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
+)
+
+// TopCmd shows the running processes of one or more containers, the same
+// information `docker top` reports, for every container matched positionally
+// or via --filter.
+func TopCmd(c *cli.Context) error {
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
+		return nil
+	}
+
+	backend, err := newBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+	defer backend.Close()
+
+	containers, err := selector.Select(c.Context, backend, c.StringSlice("filter"), c.Args().Slice())
+	if err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	psArgs := strings.Fields(c.String("ps-args"))
+
+	if c.Bool("pid-only") {
+		return printTopPIDs(c, backend, containers, psArgs)
+	}
+	if c.Bool("json") {
+		return printTopJSON(c, backend, containers, psArgs)
+	}
+	return printTopTable(c, backend, containers, psArgs)
+}
+
+// printTopPIDs prints one PID per line across all matched containers,
+// suitable for piping into kill/renice.
+func printTopPIDs(c *cli.Context, backend Backend, containers []types.Container, psArgs []string) error {
+	for _, cont := range containers {
+		top, err := backend.ContainerTop(c.Context, cont.ID, psArgs)
+		if err != nil {
+			color.Yellow("Warning: could not get top for %s: %v", containerName(cont), err)
+			continue
+		}
+		pidCol := pidColumn(top.Titles)
+		if pidCol == -1 {
+			continue
+		}
+		for _, proc := range top.Processes {
+			fmt.Println(proc[pidCol])
+		}
+	}
+	return nil
+}
+
+// topContainerProcs is the --json shape: a container's name alongside its
+// process table, one process per map keyed by column title.
+type topContainerProcs struct {
+	Container string              `json:"container"`
+	Processes []map[string]string `json:"processes"`
+}
+
+func printTopJSON(c *cli.Context, backend Backend, containers []types.Container, psArgs []string) error {
+	var out []topContainerProcs
+	for _, cont := range containers {
+		top, err := backend.ContainerTop(c.Context, cont.ID, psArgs)
+		if err != nil {
+			color.Yellow("Warning: could not get top for %s: %v", containerName(cont), err)
+			continue
+		}
+		procs := make([]map[string]string, 0, len(top.Processes))
+		for _, proc := range top.Processes {
+			row := make(map[string]string, len(top.Titles))
+			for i, title := range top.Titles {
+				if i < len(proc) {
+					row[title] = proc[i]
+				}
+			}
+			procs = append(procs, row)
+		}
+		out = append(out, topContainerProcs{Container: containerName(cont), Processes: procs})
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("json marshal error: %v", err)
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+func printTopTable(c *cli.Context, backend Backend, containers []types.Container, psArgs []string) error {
+	for _, cont := range containers {
+		top, err := backend.ContainerTop(c.Context, cont.ID, psArgs)
+		if err != nil {
+			color.Yellow("Warning: could not get top for %s: %v", containerName(cont), err)
+			continue
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader(top.Titles)
+		table.SetCaption(true, color.CyanString("Processes: %s", containerName(cont)))
+		for _, proc := range top.Processes {
+			table.Append(proc)
+		}
+		table.Render()
+	}
+	return nil
+}
+
+// pidColumn finds the index of the "PID" column in a ContainerTop Titles
+// slice; -1 if ps didn't report one (e.g. custom --ps-args).
+func pidColumn(titles []string) int {
+	for i, t := range titles {
+		if strings.EqualFold(t, "PID") {
+			return i
+		}
+	}
+	return -1
+}
+
+func containerName(cont types.Container) string {
+	return strings.TrimPrefix(cont.Names[0], "/")
+}
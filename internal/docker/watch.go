@@ -0,0 +1,94 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+	"Docker_Container_monitor/internal/selector"
+	"Docker_Container_monitor/pkg/filter"
+)
+
+// watchActions are the container event actions that change what StateCmd's
+// table shows, so WatchCmd redraws on them immediately instead of waiting
+// for the next --interval tick. "health_status" is handled separately since
+// the daemon emits it as "health_status: healthy"/"unhealthy"/"starting",
+// never the bare action name.
+var watchActions = map[string]bool{
+	"start":   true,
+	"die":     true,
+	"stop":    true,
+	"destroy": true,
+	"rename":  true,
+	"pause":   true,
+	"unpause": true,
+}
+
+// WatchCmd redraws StateCmd's table in place whenever a relevant container
+// lifecycle event occurs, and at least every --interval besides, so uptime
+// strings stay current between events. It's a lightweight always-on monitor
+// in place of polling ContainerList in a tight loop.
+func WatchCmd(c *cli.Context) error {
+	if c.Bool("filter-help") {
+		fmt.Print(filter.Help)
+		return nil
+	}
+
+	backend, err := newBackend()
+	if err != nil {
+		return errdefs.Unavailable(fmt.Errorf("docker client error: %v", err))
+	}
+	defer backend.Close()
+
+	msgChan, errChan := backend.Events(c.Context, types.EventsOptions{
+		Filters: filters.NewArgs(filters.Arg("type", "container")),
+	})
+
+	redraw := func() error {
+		containers, err := selector.Select(c.Context, backend, c.StringSlice("filter"), c.Args().Slice())
+		if err != nil {
+			return err
+		}
+		clearScreen()
+		renderStateTable(containers)
+		return nil
+	}
+
+	if err := redraw(); err != nil {
+		return errdefs.InvalidParameter(err)
+	}
+
+	ticker := time.NewTicker(c.Duration("interval"))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event := <-msgChan:
+			if !watchActions[event.Action] && !strings.HasPrefix(event.Action, "health_status") {
+				continue
+			}
+			if err := redraw(); err != nil {
+				return errdefs.InvalidParameter(err)
+			}
+		case <-ticker.C:
+			if err := redraw(); err != nil {
+				return errdefs.InvalidParameter(err)
+			}
+		case err := <-errChan:
+			return errdefs.Unavailable(fmt.Errorf("event stream error: %v", err))
+		case <-c.Context.Done():
+			return nil
+		}
+	}
+}
+
+// clearScreen moves the cursor home and clears the terminal via ANSI
+// escapes, so each redraw replaces the previous frame instead of scrolling.
+func clearScreen() {
+	fmt.Print("\033[H\033[2J")
+}
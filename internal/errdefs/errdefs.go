@@ -0,0 +1,90 @@
+// Package errdefs defines the error interfaces the rest of the codebase
+// should use to report errors in a way callers can distinguish without
+// string-matching, mirroring the pattern used by Docker's own
+// api/errdefs. Wrap an error at the point it's known (a missing container
+// name, a refused daemon socket, ...) and callers can later ask
+// "Is this a not-found?" regardless of how deep it is wrapped.
+package errdefs
+
+// ErrNotFound signals that the requested object does not exist.
+type ErrNotFound interface {
+	NotFound()
+}
+
+// ErrInvalidParameter signals bad input from the caller.
+type ErrInvalidParameter interface {
+	InvalidParameter()
+}
+
+// ErrUnauthorized signals the caller is not authorized to perform the action.
+type ErrUnauthorized interface {
+	Unauthorized()
+}
+
+// ErrConflict signals the request conflicts with the current state.
+type ErrConflict interface {
+	Conflict()
+}
+
+// ErrUnavailable signals a dependency (the Docker daemon, a remote host)
+// could not be reached.
+type ErrUnavailable interface {
+	Unavailable()
+}
+
+type causer interface {
+	Cause() error
+}
+
+// getImplementer walks err through Unwrap() and the older pkg/errors
+// Cause() chain looking for something implementing the target interface.
+func getImplementer(err error) error {
+	switch e := err.(type) {
+	case
+		ErrNotFound,
+		ErrInvalidParameter,
+		ErrUnauthorized,
+		ErrConflict,
+		ErrUnavailable:
+		return err
+	case causer:
+		return getImplementer(e.Cause())
+	case interface{ Unwrap() error }:
+		if unwrapped := e.Unwrap(); unwrapped != nil {
+			return getImplementer(unwrapped)
+		}
+		return err
+	default:
+		return err
+	}
+}
+
+// IsNotFound reports whether err, or anything it wraps, is an ErrNotFound.
+func IsNotFound(err error) bool {
+	_, ok := getImplementer(err).(ErrNotFound)
+	return ok
+}
+
+// IsInvalidParameter reports whether err, or anything it wraps, is an ErrInvalidParameter.
+func IsInvalidParameter(err error) bool {
+	_, ok := getImplementer(err).(ErrInvalidParameter)
+	return ok
+}
+
+// IsUnauthorized reports whether err, or anything it wraps, is an ErrUnauthorized.
+func IsUnauthorized(err error) bool {
+	_, ok := getImplementer(err).(ErrUnauthorized)
+	return ok
+}
+
+// IsConflict reports whether err, or anything it wraps, is an ErrConflict.
+func IsConflict(err error) bool {
+	_, ok := getImplementer(err).(ErrConflict)
+	return ok
+}
+
+// IsUnavailable reports whether err, or anything it wraps, is an ErrUnavailable.
+func IsUnavailable(err error) bool {
+	_, ok := getImplementer(err).(ErrUnavailable)
+	return ok
+}
@@ -0,0 +1,69 @@
+package errdefs
+
+type wrapped struct {
+	cause error
+}
+
+func (w wrapped) Error() string { return w.cause.Error() }
+func (w wrapped) Cause() error  { return w.cause }
+func (w wrapped) Unwrap() error { return w.cause }
+
+type notFound struct{ wrapped }
+
+func (notFound) NotFound() {}
+
+// NotFound wraps err so errdefs.IsNotFound(err) reports true.
+func NotFound(err error) error {
+	if err == nil {
+		return nil
+	}
+	return notFound{wrapped{err}}
+}
+
+type invalidParameter struct{ wrapped }
+
+func (invalidParameter) InvalidParameter() {}
+
+// InvalidParameter wraps err so errdefs.IsInvalidParameter(err) reports true.
+func InvalidParameter(err error) error {
+	if err == nil {
+		return nil
+	}
+	return invalidParameter{wrapped{err}}
+}
+
+type unauthorized struct{ wrapped }
+
+func (unauthorized) Unauthorized() {}
+
+// Unauthorized wraps err so errdefs.IsUnauthorized(err) reports true.
+func Unauthorized(err error) error {
+	if err == nil {
+		return nil
+	}
+	return unauthorized{wrapped{err}}
+}
+
+type conflict struct{ wrapped }
+
+func (conflict) Conflict() {}
+
+// Conflict wraps err so errdefs.IsConflict(err) reports true.
+func Conflict(err error) error {
+	if err == nil {
+		return nil
+	}
+	return conflict{wrapped{err}}
+}
+
+type unavailable struct{ wrapped }
+
+func (unavailable) Unavailable() {}
+
+// Unavailable wraps err so errdefs.IsUnavailable(err) reports true.
+func Unavailable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return unavailable{wrapped{err}}
+}
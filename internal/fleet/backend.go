@@ -0,0 +1,88 @@
+package fleet
+
+import (
+	"context"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+
+	"Docker_Container_monitor/internal/docker"
+)
+
+// poolBackend is a docker.Backend that resolves its pooled SSH-tunneled
+// connection on every call, so callers (e.g. the Prometheus collector) can
+// hold onto one across reconnects instead of re-resolving it themselves.
+type poolBackend struct {
+	pool *Pool
+	host Host
+}
+
+// HostBackend returns a docker.Backend for h backed by the pool, suitable
+// for long-lived use (e.g. registering once with a metrics.Collector).
+func (p *Pool) HostBackend(h Host) docker.Backend {
+	return &poolBackend{pool: p, host: h}
+}
+
+func (b *poolBackend) ContainerList(ctx context.Context, options types.ContainerListOptions) ([]types.Container, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ContainerList(ctx, options)
+}
+
+func (b *poolBackend) ContainerStats(ctx context.Context, containerID string, stream bool) (types.ContainerStats, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return types.ContainerStats{}, err
+	}
+	return backend.ContainerStats(ctx, containerID, stream)
+}
+
+func (b *poolBackend) ContainerLogs(ctx context.Context, containerID string, options types.ContainerLogsOptions) (io.ReadCloser, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return nil, err
+	}
+	return backend.ContainerLogs(ctx, containerID, options)
+}
+
+func (b *poolBackend) ContainerTop(ctx context.Context, containerID string, arguments []string) (container.ContainerTopOKBody, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return container.ContainerTopOKBody{}, err
+	}
+	return backend.ContainerTop(ctx, containerID, arguments)
+}
+
+func (b *poolBackend) ContainerInspect(ctx context.Context, containerID string) (types.ContainerJSON, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return types.ContainerJSON{}, err
+	}
+	return backend.ContainerInspect(ctx, containerID)
+}
+
+func (b *poolBackend) Events(ctx context.Context, options types.EventsOptions) (<-chan events.Message, <-chan error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		errCh := make(chan error, 1)
+		errCh <- err
+		return nil, errCh
+	}
+	return backend.Events(ctx, options)
+}
+
+func (b *poolBackend) ContainerExec(ctx context.Context, containerID string, cmd []string) (int, error) {
+	backend, err := b.pool.Backend(b.host)
+	if err != nil {
+		return 0, err
+	}
+	return backend.ContainerExec(ctx, containerID, cmd)
+}
+
+// Close is a no-op: the pool owns the underlying SSH connection's
+// lifecycle, not any single caller.
+func (b *poolBackend) Close() error { return nil }
@@ -0,0 +1,54 @@
+// Package fleet aggregates several remote Docker daemons, each reached by
+// tunneling the Docker Engine API over a pooled SSH connection, so a single
+// `monitor serve` process can watch many hosts without exposing any daemon
+// socket over TCP.
+package fleet
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Host describes one fleet member loaded from a --fleet hosts.yaml file.
+type Host struct {
+	Alias  string            `yaml:"alias"`
+	User   string            `yaml:"user"`
+	Host   string            `yaml:"host"`
+	Port   string            `yaml:"port"`
+	Key    string            `yaml:"key"`
+	Labels map[string]string `yaml:"labels"`
+}
+
+type hostsFile struct {
+	Hosts []Host `yaml:"hosts"`
+}
+
+// LoadHosts reads a fleet inventory file, e.g.:
+//
+//	hosts:
+//	  - alias: prod-1
+//	    user: deploy
+//	    host: 10.0.0.5
+//	    key: ~/.ssh/id_ed25519
+//	    labels: {env: prod}
+func LoadHosts(path string) ([]Host, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fleet config: %v", err)
+	}
+	var f hostsFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("parse fleet config: %v", err)
+	}
+	if len(f.Hosts) == 0 {
+		return nil, fmt.Errorf("no hosts defined in %s", path)
+	}
+	for _, h := range f.Hosts {
+		if h.Alias == "" || h.Host == "" {
+			return nil, fmt.Errorf("fleet config %s: host entry missing alias or host", path)
+		}
+	}
+	return f.Hosts, nil
+}
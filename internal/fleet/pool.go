@@ -0,0 +1,155 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	dockerclient "github.com/docker/docker/client"
+	"golang.org/x/crypto/ssh"
+
+	"Docker_Container_monitor/internal/docker"
+	intssh "Docker_Container_monitor/internal/ssh"
+)
+
+const defaultSSHPort = "22"
+
+// conn tracks one pooled SSH connection and its health for Pool.Status.
+type conn struct {
+	mu        sync.Mutex
+	client    *ssh.Client
+	backend   docker.Backend
+	connected bool
+	lastError error
+	lastSeen  time.Time
+}
+
+// Pool keeps one reusable, keepalive-checked SSH connection per fleet host
+// and tunnels the remote /var/run/docker.sock over it, so every host is
+// reached through the same docker.Backend interface as a local daemon.
+type Pool struct {
+	mu    sync.RWMutex
+	hosts []Host
+	conns map[string]*conn
+}
+
+// NewPool builds a Pool for hosts. No connections are dialed until a
+// caller asks for a host's Backend.
+func NewPool(hosts []Host) *Pool {
+	conns := make(map[string]*conn, len(hosts))
+	for _, h := range hosts {
+		conns[h.Alias] = &conn{}
+	}
+	return &Pool{hosts: hosts, conns: conns}
+}
+
+// Hosts returns the fleet members the pool was built with.
+func (p *Pool) Hosts() []Host {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.hosts
+}
+
+// HostStatus reports one fleet member's connection state, for /hosts.
+type HostStatus struct {
+	Alias     string    `json:"alias"`
+	Connected bool      `json:"connected"`
+	LastError string    `json:"last_error,omitempty"`
+	LastSeen  time.Time `json:"last_seen,omitempty"`
+}
+
+// Status returns the current connection state of every fleet member.
+func (p *Pool) Status() []HostStatus {
+	p.mu.RLock()
+	hosts := p.hosts
+	p.mu.RUnlock()
+
+	out := make([]HostStatus, 0, len(hosts))
+	for _, h := range hosts {
+		c := p.conns[h.Alias]
+		c.mu.Lock()
+		s := HostStatus{Alias: h.Alias, Connected: c.connected, LastSeen: c.lastSeen}
+		if c.lastError != nil {
+			s.LastError = c.lastError.Error()
+		}
+		c.mu.Unlock()
+		out = append(out, s)
+	}
+	return out
+}
+
+// Backend returns a docker.Backend tunneled over a pooled SSH connection to
+// h, reusing the existing connection if its keepalive still succeeds and
+// reconnecting otherwise.
+func (p *Pool) Backend(h Host) (docker.Backend, error) {
+	p.mu.RLock()
+	c, ok := p.conns[h.Alias]
+	p.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown fleet host %q", h.Alias)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		if _, _, err := c.client.SendRequest("keepalive@monitor", true, nil); err == nil {
+			c.lastSeen = time.Now()
+			return c.backend, nil
+		}
+		c.client.Close()
+		c.client = nil
+		c.backend = nil
+		c.connected = false
+	}
+
+	backend, sshClient, err := dial(h)
+	if err != nil {
+		c.connected = false
+		c.lastError = err
+		return nil, err
+	}
+
+	c.client = sshClient
+	c.backend = backend
+	c.connected = true
+	c.lastSeen = time.Now()
+	c.lastError = nil
+	return backend, nil
+}
+
+// dial opens an SSH connection to h and returns a docker.Backend that
+// talks to h's Docker Engine API by dialing h's own
+// /var/run/docker.sock through that SSH connection, never exposing the
+// daemon socket over TCP.
+func dial(h Host) (docker.Backend, *ssh.Client, error) {
+	clientConfig, err := intssh.ClientConfig(h.User, h.Key, "", false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ssh config for %s: %v", h.Alias, err)
+	}
+
+	port := h.Port
+	if port == "" {
+		port = defaultSSHPort
+	}
+	sshClient, err := ssh.Dial("tcp", net.JoinHostPort(h.Host, port), clientConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s: %v", h.Alias, err)
+	}
+
+	cli, err := dockerclient.NewClientWithOpts(
+		dockerclient.WithHost("unix:///var/run/docker.sock"),
+		dockerclient.WithDialContext(func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return sshClient.Dial("unix", "/var/run/docker.sock")
+		}),
+		dockerclient.WithAPIVersionNegotiation(),
+	)
+	if err != nil {
+		sshClient.Close()
+		return nil, nil, fmt.Errorf("docker client for %s: %v", h.Alias, err)
+	}
+
+	return docker.WrapClient(cli), sshClient, nil
+}
@@ -0,0 +1,121 @@
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli/v2"
+
+	"Docker_Container_monitor/internal/errdefs"
+)
+
+// hostResult is one fleet member's containers (or the error reaching it),
+// collected by queryAll so a single unreachable host never aborts the
+// others.
+type hostResult struct {
+	Host       string
+	Containers []types.Container
+	Err        error
+}
+
+// RemoteCmd dials every host in a --fleet hosts.yaml inventory concurrently
+// over its pooled SSH tunnel and merges their containers into one table or
+// JSON array, each row/object tagged with its source host. This is the
+// multi-host counterpart to ssh.RemoteCmd's single-host mode.
+func RemoteCmd(c *cli.Context) error {
+	hosts, err := LoadHosts(c.String("fleet"))
+	if err != nil {
+		return errdefs.InvalidParameter(fmt.Errorf("fleet config error: %v", err))
+	}
+	pool := NewPool(hosts)
+
+	useJSON := c.Bool("json")
+	if !useJSON {
+		color.Cyan("Connecting to %d fleet host(s)...", len(hosts))
+	}
+
+	results := queryAll(c.Context, pool, hosts)
+	return printFleetResults(results, useJSON)
+}
+
+// queryAll lists containers on every host concurrently. One host's failure
+// is recorded in its own result and never stops the others.
+func queryAll(ctx context.Context, pool *Pool, hosts []Host) []hostResult {
+	results := make([]hostResult, len(hosts))
+	var wg sync.WaitGroup
+	for i, h := range hosts {
+		wg.Add(1)
+		go func(i int, h Host) {
+			defer wg.Done()
+			backend, err := pool.Backend(h)
+			if err != nil {
+				results[i] = hostResult{Host: h.Alias, Err: err}
+				return
+			}
+			containers, err := backend.ContainerList(ctx, types.ContainerListOptions{})
+			results[i] = hostResult{Host: h.Alias, Containers: containers, Err: err}
+		}(i, h)
+	}
+	wg.Wait()
+	return results
+}
+
+// taggedContainer adds a "host" field to a container so a merged fleet JSON
+// array can be traced back to its source.
+type taggedContainer struct {
+	types.Container
+	Host string `json:"host"`
+}
+
+// printFleetResults merges every host's containers into one table (with a
+// Host column) or one JSON array, then reports any hosts that failed
+// without hiding the results from the hosts that succeeded.
+func printFleetResults(results []hostResult, useJSON bool) error {
+	var tagged []taggedContainer
+	var failed []string
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", r.Host, r.Err))
+			continue
+		}
+		for _, cont := range r.Containers {
+			tagged = append(tagged, taggedContainer{Container: cont, Host: r.Host})
+		}
+	}
+
+	if useJSON {
+		b, err := json.Marshal(tagged)
+		if err != nil {
+			return fmt.Errorf("json marshal error: %v", err)
+		}
+		fmt.Println(string(b))
+	} else if len(tagged) == 0 {
+		color.Yellow("No running containers on fleet host(s)!")
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Host", "Container", "Status"})
+		table.SetCaption(true, color.GreenString("Fleet Containers"))
+		for _, t := range tagged {
+			table.Append([]string{t.Host, strings.TrimPrefix(t.Names[0], "/"), t.Status})
+		}
+		table.Render()
+	}
+
+	if len(failed) > 0 {
+		color.Red("Failed to reach %d of %d host(s):", len(failed), len(results))
+		for _, f := range failed {
+			color.Red("  %s", f)
+		}
+		if len(failed) == len(results) {
+			return fmt.Errorf("all fleet hosts failed")
+		}
+	}
+	return nil
+}
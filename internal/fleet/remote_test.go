@@ -0,0 +1,28 @@
+package fleet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+)
+
+func TestPrintFleetResultsAllFailed(t *testing.T) {
+	results := []hostResult{
+		{Host: "prod-1", Err: errors.New("dial timeout")},
+		{Host: "prod-2", Err: errors.New("auth failed")},
+	}
+	if err := printFleetResults(results, true); err == nil {
+		t.Fatal("expected an error when every fleet host fails")
+	}
+}
+
+func TestPrintFleetResultsPartialFailure(t *testing.T) {
+	results := []hostResult{
+		{Host: "prod-1", Containers: []types.Container{{Names: []string{"/web"}, Status: "Up 1 hour"}}},
+		{Host: "prod-2", Err: errors.New("dial timeout")},
+	}
+	if err := printFleetResults(results, true); err != nil {
+		t.Fatalf("expected a partial failure to still succeed overall, got: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+// Package metrics exposes per-container Docker statistics as Prometheus
+// metrics by scraping the Docker Engine API on every /metrics request.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"Docker_Container_monitor/internal/docker"
+)
+
+var (
+	cpuUsage = prometheus.NewDesc(
+		"docker_container_cpu_usage_seconds_total",
+		"Cumulative CPU time consumed by the container, in seconds.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	memUsage = prometheus.NewDesc(
+		"docker_container_memory_usage_bytes",
+		"Current memory usage of the container, in bytes.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	memLimit = prometheus.NewDesc(
+		"docker_container_memory_limit_bytes",
+		"Memory limit of the container, in bytes.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	netRx = prometheus.NewDesc(
+		"docker_container_network_receive_bytes_total",
+		"Cumulative bytes received over the network.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	netTx = prometheus.NewDesc(
+		"docker_container_network_transmit_bytes_total",
+		"Cumulative bytes transmitted over the network.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	blkRead = prometheus.NewDesc(
+		"docker_container_block_io_read_bytes_total",
+		"Cumulative bytes read from block devices.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	blkWrite = prometheus.NewDesc(
+		"docker_container_block_io_write_bytes_total",
+		"Cumulative bytes written to block devices.",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+	running = prometheus.NewDesc(
+		"docker_container_running",
+		"Whether the container is currently running (1) or not (0).",
+		[]string{"host", "id", "name", "image"}, nil,
+	)
+)
+
+// Collector scrapes the Docker Engine API on every Prometheus scrape and
+// emits per-container gauges/counters, labeled with host so a single
+// registry can serve both the local daemon and a fleet of remote ones. It
+// implements prometheus.Collector.
+type Collector struct {
+	host    string
+	backend docker.Backend
+	timeout time.Duration
+}
+
+// NewCollector returns a Collector backed by backend, labeling every metric
+// with host (use "local" for the process's own daemon). A scrape that takes
+// longer than timeout is aborted so a slow or wedged daemon can't block
+// the whole /metrics response.
+func NewCollector(host string, backend docker.Backend, timeout time.Duration) *Collector {
+	return &Collector{host: host, backend: backend, timeout: timeout}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cpuUsage
+	ch <- memUsage
+	ch <- memLimit
+	ch <- netRx
+	ch <- netTx
+	ch <- blkRead
+	ch <- blkWrite
+	ch <- running
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	containers, err := c.backend.ContainerList(ctx, types.ContainerListOptions{All: true})
+	if err != nil {
+		return
+	}
+
+	for _, cont := range containers {
+		name := strings.TrimPrefix(cont.Names[0], "/")
+		labels := []string{c.host, cont.ID, name, cont.Image}
+
+		isRunning := 0.0
+		if cont.State == "running" {
+			isRunning = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(running, prometheus.GaugeValue, isRunning, labels...)
+
+		if cont.State != "running" {
+			continue
+		}
+
+		resp, err := c.backend.ContainerStats(ctx, cont.ID, false)
+		if err != nil {
+			continue
+		}
+		var v types.StatsJSON
+		err = json.NewDecoder(resp.Body).Decode(&v)
+		resp.Body.Close()
+		if err != nil {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(cpuUsage, prometheus.CounterValue, float64(v.CPUStats.CPUUsage.TotalUsage)/1e9, labels...)
+		ch <- prometheus.MustNewConstMetric(memUsage, prometheus.GaugeValue, float64(v.MemoryStats.Usage), labels...)
+		ch <- prometheus.MustNewConstMetric(memLimit, prometheus.GaugeValue, float64(v.MemoryStats.Limit), labels...)
+
+		var rx, tx uint64
+		for _, n := range v.Networks {
+			rx += n.RxBytes
+			tx += n.TxBytes
+		}
+		ch <- prometheus.MustNewConstMetric(netRx, prometheus.CounterValue, float64(rx), labels...)
+		ch <- prometheus.MustNewConstMetric(netTx, prometheus.CounterValue, float64(tx), labels...)
+
+		var read, write uint64
+		for _, e := range v.BlkioStats.IoServiceBytesRecursive {
+			switch strings.ToLower(e.Op) {
+			case "read":
+				read += e.Value
+			case "write":
+				write += e.Value
+			}
+		}
+		ch <- prometheus.MustNewConstMetric(blkRead, prometheus.CounterValue, float64(read), labels...)
+		ch <- prometheus.MustNewConstMetric(blkWrite, prometheus.CounterValue, float64(write), labels...)
+	}
+}
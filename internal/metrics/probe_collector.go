@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"Docker_Container_monitor/internal/docker"
+)
+
+var serviceProbeUp = prometheus.NewDesc(
+	"monitor_service_probe_up",
+	"Whether a container's exposed port passed its monitor.probe check (1) or not (0).",
+	[]string{"container", "port", "probe"}, nil,
+)
+
+// ProbeCollector scrapes every container's exposed ports with the same
+// probes ServiceCmd uses and reports each as a Prometheus gauge, so
+// "monitor exporter" can back a monitor_service_probe_up alert instead of
+// ServiceCmd's ad-hoc --alert webhook.
+type ProbeCollector struct {
+	backend   docker.Backend
+	threshold time.Duration
+	timeout   time.Duration
+}
+
+// NewProbeCollector returns a ProbeCollector backed by backend. threshold
+// is the response time above which docker.CollectServiceChecks reports a
+// probe as slow rather than available; timeout bounds how long one
+// scrape may take.
+func NewProbeCollector(backend docker.Backend, threshold, timeout time.Duration) *ProbeCollector {
+	return &ProbeCollector{backend: backend, threshold: threshold, timeout: timeout}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ProbeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- serviceProbeUp
+}
+
+// Collect implements prometheus.Collector.
+func (c *ProbeCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	results, err := docker.CollectServiceChecks(ctx, c.backend, c.threshold)
+	if err != nil {
+		return
+	}
+	for _, r := range results {
+		up := 0.0
+		if r.Status == "available" {
+			up = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(serviceProbeUp, prometheus.GaugeValue, up, r.Container, r.Port, r.Probe)
+	}
+}
@@ -0,0 +1,108 @@
+// Package selector implements the container-targeting flags shared by
+// state, top and logs: --filter (see pkg/filter) to narrow the fleet,
+// optionally further narrowed to the container names/IDs given
+// positionally, so every command targets containers the same way.
+package selector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/fatih/color"
+
+	"Docker_Container_monitor/pkg/filter"
+)
+
+// Backend is the subset of docker.Backend that Select needs. It's declared
+// independently (rather than importing the docker package's Backend)
+// because the docker package itself imports selector for StateCmd/LogsCmd,
+// and Go doesn't allow that cycle.
+type Backend interface {
+	ContainerList(ctx context.Context, options types.ContainerListOptions) ([]types.Container, error)
+	ContainerStats(ctx context.Context, containerID string, stream bool) (types.ContainerStats, error)
+}
+
+// Select resolves the containers a command should operate on: everything
+// matching rawFilters (--filter), further narrowed to names if any were
+// given (container name or ID, matched literally).
+func Select(ctx context.Context, backend Backend, rawFilters []string, names []string) ([]types.Container, error) {
+	matcher, err := filter.Parse(rawFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := backend.ContainerList(ctx, types.ContainerListOptions{All: true, Filters: matcher.Args})
+	if err != nil {
+		return nil, fmt.Errorf("container list failed: %v", err)
+	}
+
+	containers, err = applyComputed(ctx, backend, matcher, containers)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return containers, nil
+	}
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[n] = true
+	}
+	narrowed := containers[:0]
+	for _, cont := range containers {
+		name := strings.TrimPrefix(cont.Names[0], "/")
+		if wanted[name] || wanted[cont.ID] {
+			narrowed = append(narrowed, cont)
+		}
+	}
+	return narrowed, nil
+}
+
+// applyComputed narrows containers to those matching m's computed filters
+// (e.g. "uptime>1h", "cpu>50"); m's server-side keys were already applied
+// via ContainerListOptions.Filters. CPU is only fetched, one stats call
+// per container, when m actually has a "cpu" filter.
+func applyComputed(ctx context.Context, backend Backend, m *filter.Matcher, containers []types.Container) ([]types.Container, error) {
+	if !m.NeedsCPU() {
+		matched := containers[:0]
+		for _, cont := range containers {
+			if m.Match(cont, 0) {
+				matched = append(matched, cont)
+			}
+		}
+		return matched, nil
+	}
+
+	var matched []types.Container
+	for _, cont := range containers {
+		cpuPercent, err := containerCPUPercent(ctx, backend, cont.ID)
+		if err != nil {
+			color.Yellow("Warning: could not get stats for %s, skipping --filter cpu check", strings.TrimPrefix(cont.Names[0], "/"))
+			continue
+		}
+		if m.Match(cont, cpuPercent) {
+			matched = append(matched, cont)
+		}
+	}
+	return matched, nil
+}
+
+// containerCPUPercent fetches one non-streaming stats sample for id and
+// computes its instantaneous CPU percentage, the same calculation
+// StatsCmd uses.
+func containerCPUPercent(ctx context.Context, backend Backend, id string) (float64, error) {
+	resp, err := backend.ContainerStats(ctx, id, false)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var v types.StatsJSON
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return 0, err
+	}
+	return filter.CalculateCPUPercent(&v), nil
+}
@@ -0,0 +1,232 @@
+package ssh
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kevinburke/ssh_config"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const defaultSSHPort = "22"
+
+// resolveAlias builds a client config by reading ~/.ssh/config for alias,
+// resolving HostName, Port, User and IdentityFile the way the openssh
+// client would.
+func resolveAlias(alias string, insecureHostKey bool) (*ssh.ClientConfig, string, error) {
+	sshConfigPath := os.ExpandEnv("$HOME/.ssh/config")
+	f, err := os.Open(sshConfigPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot open SSH config: %v", err)
+	}
+	defer f.Close()
+
+	cfg, err := ssh_config.Decode(f)
+	if err != nil {
+		return nil, "", fmt.Errorf("decode error: %v", err)
+	}
+
+	hostname, err := cfg.Get(alias, "HostName")
+	if err != nil || hostname == "" {
+		return nil, "", fmt.Errorf("HostName not found for %s", alias)
+	}
+
+	user, err := cfg.Get(alias, "User")
+	if err != nil || user == "" {
+		user = os.Getenv("USER")
+	}
+
+	port, err := cfg.Get(alias, "Port")
+	if err != nil || port == "" {
+		port = defaultSSHPort
+	}
+
+	keyPath, err := cfg.Get(alias, "IdentityFile")
+	if err != nil || keyPath == "" {
+		keyPath = "~/.ssh/id_rsa"
+	}
+	keyPath, err = expandPath(keyPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("key path error: %v", err)
+	}
+
+	clientConfig, err := buildClientConfig(user, keyPath, "", insecureHostKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return clientConfig, net.JoinHostPort(hostname, port), nil
+}
+
+// resolveManual builds a client config from a "user@host" string and an
+// explicit private key path, bypassing ~/.ssh/config entirely.
+func resolveManual(userHost, keyPath, passphrase string, insecureHostKey bool) (*ssh.ClientConfig, string, error) {
+	parts := strings.SplitN(userHost, "@", 2)
+	if len(parts) != 2 {
+		return nil, "", fmt.Errorf("invalid user@host: %s", userHost)
+	}
+	user, host := parts[0], parts[1]
+
+	clientConfig, err := ClientConfig(user, keyPath, passphrase, insecureHostKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return clientConfig, net.JoinHostPort(host, defaultSSHPort), nil
+}
+
+// ClientConfig builds an *ssh.ClientConfig for user from the private key
+// at keyPath (expanding a leading "~"), for callers outside this package
+// that already know the target host and don't need alias resolution
+// (e.g. internal/fleet, which reads its own hosts.yaml).
+func ClientConfig(user, keyPath, passphrase string, insecureHostKey bool) (*ssh.ClientConfig, error) {
+	keyPath, err := expandPath(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("key path error: %v", err)
+	}
+	return buildClientConfig(user, keyPath, passphrase, insecureHostKey)
+}
+
+// buildClientConfig assembles auth methods (agent first, then the key file
+// at keyPath if any) and a host key callback for user.
+func buildClientConfig(user, keyPath, passphrase string, insecureHostKey bool) (*ssh.ClientConfig, error) {
+	auth, err := authMethods(keyPath, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := newHostKeyCallback(insecureHostKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         10 * time.Second,
+	}, nil
+}
+
+// authMethods prefers a running ssh-agent (SSH_AUTH_SOCK) and falls back to
+// the private key at keyPath, decrypting it with passphrase if needed.
+func authMethods(keyPath, passphrase string) ([]ssh.AuthMethod, error) {
+	var methods []ssh.AuthMethod
+
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	if keyPath != "" {
+		key, err := os.ReadFile(keyPath)
+		if err != nil {
+			if len(methods) > 0 {
+				return methods, nil
+			}
+			return nil, fmt.Errorf("cannot read key at %s: %v", keyPath, err)
+		}
+
+		signer, err := ssh.ParsePrivateKey(key)
+		if _, missing := err.(*ssh.PassphraseMissingError); missing {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+		}
+		if err != nil {
+			if len(methods) > 0 {
+				return methods, nil
+			}
+			return nil, fmt.Errorf("cannot parse key at %s: %v", keyPath, err)
+		}
+		methods = append(methods, ssh.PublicKeys(signer))
+	}
+
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no SSH authentication method available (no agent, no key)")
+	}
+	return methods, nil
+}
+
+// newHostKeyCallback verifies the server against ~/.ssh/known_hosts unless
+// insecure opts out of verification entirely.
+func newHostKeyCallback(insecure bool) (ssh.HostKeyCallback, error) {
+	if insecure {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	knownHostsFile := os.ExpandEnv("$HOME/.ssh/known_hosts")
+	verify, err := knownhosts.New(knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("host key callback error: %v", err)
+	}
+
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if err := verify(hostname, remote, key); err != nil {
+			return &HostKeyMismatchError{Host: hostname, Err: err}
+		}
+		return nil
+	}, nil
+}
+
+// ExpandHostAliases resolves a --host token to one or more concrete SSH
+// config aliases. A token without '*'/'?' is returned as-is; a glob
+// (e.g. "prod-*") is expanded against every concrete alias declared in
+// ~/.ssh/config, using the same pattern matching ssh_config applies to
+// "Host" lines.
+func ExpandHostAliases(token string) ([]string, error) {
+	if !strings.ContainsAny(token, "*?") {
+		return []string{token}, nil
+	}
+
+	sshConfigPath := os.ExpandEnv("$HOME/.ssh/config")
+	f, err := os.Open(sshConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open SSH config: %v", err)
+	}
+	defer f.Close()
+
+	cfg, err := ssh_config.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decode error: %v", err)
+	}
+
+	group, err := ssh_config.NewPattern(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host group %q: %v", token, err)
+	}
+	matcher := &ssh_config.Host{Patterns: []*ssh_config.Pattern{group}}
+
+	seen := make(map[string]bool)
+	var aliases []string
+	for _, host := range cfg.Hosts {
+		for _, pattern := range host.Patterns {
+			alias := pattern.String()
+			if strings.ContainsAny(alias, "*?!") || seen[alias] {
+				continue
+			}
+			if matcher.Matches(alias) {
+				seen[alias] = true
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+	if len(aliases) == 0 {
+		return nil, fmt.Errorf("no hosts in %s match %q", sshConfigPath, token)
+	}
+	return aliases, nil
+}
+
+// expandPath expands a leading "~" to the user's home directory.
+func expandPath(path string) (string, error) {
+	if strings.HasPrefix(path, "~") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return strings.Replace(path, "~", home, 1), nil
+	}
+	return path, nil
+}
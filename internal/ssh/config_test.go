@@ -0,0 +1,80 @@
+package ssh
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatalf("UserHomeDir: %v", err)
+	}
+
+	got, err := expandPath("~/.ssh/id_ed25519")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := filepath.Join(home, ".ssh/id_ed25519")
+	if got != want {
+		t.Fatalf("expandPath() = %q, want %q", got, want)
+	}
+
+	if got, err := expandPath("/already/absolute"); err != nil || got != "/already/absolute" {
+		t.Fatalf("expandPath() = %q, %v, want unchanged path", got, err)
+	}
+}
+
+func TestNewHostKeyCallbackInsecure(t *testing.T) {
+	cb, err := newHostKeyCallback(true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cb("example.com:22", nil, nil); err != nil {
+		t.Fatalf("insecure callback should accept any key, got: %v", err)
+	}
+}
+
+func TestAuthMethodsNoAgentNoKey(t *testing.T) {
+	t.Setenv("SSH_AUTH_SOCK", "")
+	if _, err := authMethods("", ""); err == nil {
+		t.Fatal("expected an error when neither an agent nor a key is available")
+	}
+}
+
+func TestExpandHostAliasesPassesThroughPlainAlias(t *testing.T) {
+	got, err := ExpandHostAliases("prod-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "prod-1" {
+		t.Fatalf("ExpandHostAliases(%q) = %v, want [prod-1] unchanged", "prod-1", got)
+	}
+}
+
+func TestExpandHostAliasesMatchesWildcardGroup(t *testing.T) {
+	home := t.TempDir()
+	sshDir := filepath.Join(home, ".ssh")
+	if err := os.MkdirAll(sshDir, 0o700); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+	config := "Host prod-1 prod-2\n  HostName 10.0.0.1\n\nHost staging-1\n  HostName 10.0.0.2\n"
+	if err := os.WriteFile(filepath.Join(sshDir, "config"), []byte(config), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	t.Setenv("HOME", home)
+
+	got, err := ExpandHostAliases("prod-*")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"prod-1", "prod-2"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("ExpandHostAliases(%q) = %v, want %v", "prod-*", got, want)
+	}
+
+	if _, err := ExpandHostAliases("nonexistent-*"); err == nil {
+		t.Fatal("expected an error when a wildcard group matches no hosts")
+	}
+}
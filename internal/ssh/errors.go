@@ -0,0 +1,17 @@
+package ssh
+
+import "fmt"
+
+// HostKeyMismatchError is returned when the remote host's key does not
+// match any entry in known_hosts, so callers can distinguish a security
+// rejection from a plain dial/auth failure.
+type HostKeyMismatchError struct {
+	Host string
+	Err  error
+}
+
+func (e *HostKeyMismatchError) Error() string {
+	return fmt.Sprintf("host key verification failed for %s: %v", e.Host, e.Err)
+}
+
+func (e *HostKeyMismatchError) Unwrap() error { return e.Err }
@@ -3,58 +3,147 @@ package ssh
 import (
 	"bytes"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/crypto/ssh"
 
-	"Docker_Container_monitor/internal/ssh"
+	"Docker_Container_monitor/internal/errdefs"
 )
 
+// remoteMaxWorkers bounds how many hosts RemoteCmd dials at once, so a
+// "--host prod-*" group matching dozens of aliases doesn't open dozens of
+// simultaneous SSH connections.
+const remoteMaxWorkers = 5
+
+// target is one host RemoteCmd should dial: an SSH client config plus the
+// alias it was resolved from, used to tag output when querying more than
+// one host.
+type target struct {
+	alias  string
+	config *ssh.ClientConfig
+	addr   string
+}
+
+// hostResult is one target's outcome, collected by fetchAll so a single
+// unreachable host never aborts the others.
+type hostResult struct {
+	Alias string
+	Lines []string
+	Err   error
+}
+
+// RemoteCmd connects to one or more remote Docker hosts via SSH and prints
+// their merged `docker ps` output. Resolution order: explicit "--host
+// <alias[,alias...]|pattern>" (aliases and wildcard groups such as
+// "prod-*" are read from ~/.ssh/config), otherwise a positional
+// "<user>@<host>" argument with "-i <key>".
 func RemoteCmd(c *cli.Context) error {
 	useJSON := c.Bool("json")
-	hostAlias := c.String("host")
-
-	var (
-		clientConfig *ssh.ClientConfig
-		remoteAddr   string
-		err          error
-	)
+	insecureHostKey := c.Bool("insecure-host-key")
 
-	if hostAlias != "" {
-		// from ~/.ssh/config
-		clientConfig, remoteAddr, err = getSSHConfig(hostAlias)
+	var targets []target
+	switch {
+	case c.String("host") != "":
+		aliases, err := expandHostArg(c.String("host"))
 		if err != nil {
-			return fmt.Errorf("SSH config error for '%s': %v", hostAlias, err)
+			return err
 		}
-	} else if c.Args().Len() > 0 {
-		// manual user@host + -i key
+		for _, alias := range aliases {
+			clientConfig, remoteAddr, err := resolveAlias(alias, insecureHostKey)
+			if err != nil {
+				return fmt.Errorf("SSH config error for '%s': %v", alias, err)
+			}
+			targets = append(targets, target{alias: alias, config: clientConfig, addr: remoteAddr})
+		}
+	case c.Args().Len() > 0:
 		userHost := c.Args().Get(0)
 		keyPath := c.String("key")
 		if keyPath == "" {
 			return fmt.Errorf("missing SSH key: use -i <path>")
 		}
-		clientConfig, remoteAddr, err = getManualSSHConfig(userHost, keyPath)
+		clientConfig, remoteAddr, err := resolveManual(userHost, keyPath, c.String("passphrase"), insecureHostKey)
 		if err != nil {
 			return fmt.Errorf("SSH manual config error for '%s': %v", userHost, err)
 		}
-	} else {
-		return fmt.Errorf("please specify --host <alias> or <user>@<host> -i <key>")
+		targets = append(targets, target{alias: userHost, config: clientConfig, addr: remoteAddr})
+	default:
+		return fmt.Errorf("please specify --host <alias[,alias...]|pattern>, or <user>@<host> -i <key>")
 	}
 
 	if !useJSON {
-		color.Cyan("Connecting to %s...", remoteAddr)
+		if len(targets) == 1 {
+			color.Cyan("Connecting to %s...", targets[0].addr)
+		} else {
+			color.Cyan("Connecting to %d host(s)...", len(targets))
+		}
 	}
 
-	// Dial
-	conn, err := ssh.Dial("tcp", remoteAddr, clientConfig)
+	results := fetchAll(targets, useJSON)
+	return printResults(results, useJSON)
+}
+
+// expandHostArg splits a --host value on commas and expands every
+// wildcard token (e.g. "prod-*") against ~/.ssh/config, returning the
+// deduplicated, order-preserving list of concrete aliases to dial.
+func expandHostArg(raw string) ([]string, error) {
+	seen := make(map[string]bool)
+	var aliases []string
+	for _, token := range strings.Split(raw, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		expanded, err := ExpandHostAliases(token)
+		if err != nil {
+			return nil, fmt.Errorf("SSH config error for '%s': %v", token, err)
+		}
+		for _, alias := range expanded {
+			if seen[alias] {
+				continue
+			}
+			seen[alias] = true
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases, nil
+}
+
+// fetchAll dials every target concurrently, bounded by remoteMaxWorkers,
+// so one unreachable host never blocks or aborts the others.
+func fetchAll(targets []target, useJSON bool) []hostResult {
+	results := make([]hostResult, len(targets))
+	sem := make(chan struct{}, remoteMaxWorkers)
+	var wg sync.WaitGroup
+
+	for i, t := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, t target) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			lines, err := fetchDockerPS(t.config, t.addr, useJSON)
+			results[i] = hostResult{Alias: t.alias, Lines: lines, Err: err}
+		}(i, t)
+	}
+	wg.Wait()
+	return results
+}
+
+// fetchDockerPS dials addr and runs `docker ps`, returning its output
+// split into non-empty lines.
+func fetchDockerPS(clientConfig *ssh.ClientConfig, addr string, useJSON bool) ([]string, error) {
+	conn, err := ssh.Dial("tcp", addr, clientConfig)
 	if err != nil {
-		return fmt.Errorf("failed to dial %s: %v", remoteAddr, err)
+		return nil, errdefs.Unavailable(fmt.Errorf("failed to dial %s: %v", addr, err))
 	}
 	defer conn.Close()
 
 	session, err := conn.NewSession()
 	if err != nil {
-		return fmt.Errorf("failed to create session: %v", err)
+		return nil, fmt.Errorf("failed to create session: %v", err)
 	}
 	defer session.Close()
 
@@ -62,18 +151,78 @@ func RemoteCmd(c *cli.Context) error {
 	session.Stdout = &out
 	session.Stderr = &out
 
-	// Build remote docker command string
 	cmdStr := "docker ps --format '📂 {{.Names}}: 🔹 {{.Status}}'"
 	if useJSON {
 		cmdStr = "docker ps --format '{{json .}}'"
 	}
 
-	// Run
 	if err := session.Run(cmdStr); err != nil {
-		return fmt.Errorf("remote command error: %v\n%s", err, out.String())
+		return nil, fmt.Errorf("remote command error: %v\n%s", err, out.String())
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimRight(out.String(), "\n"), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// printResults merges every host's output lines into one stream (each
+// line tagged "[alias]" once more than one host was queried) or one JSON
+// array (each object tagged with a "host" field), then reports any hosts
+// that failed without hiding the results from the hosts that succeeded.
+func printResults(results []hostResult, useJSON bool) error {
+	var jsonObjects []string
+	var lines []string
+	var failed []string
+	multi := len(results) > 1
+
+	for _, r := range results {
+		if r.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", r.Alias, r.Err))
+			continue
+		}
+		for _, line := range r.Lines {
+			switch {
+			case useJSON:
+				jsonObjects = append(jsonObjects, injectHost(line, r.Alias))
+			case multi:
+				lines = append(lines, fmt.Sprintf("[%s] %s", r.Alias, line))
+			default:
+				lines = append(lines, line)
+			}
+		}
 	}
 
-	// Print result
-	fmt.Print(out.String())
+	if useJSON {
+		fmt.Println("[" + strings.Join(jsonObjects, ",") + "]")
+	} else if len(lines) == 0 {
+		color.Yellow("No running containers on remote host(s)!")
+	} else {
+		fmt.Println(strings.Join(lines, "\n"))
+	}
+
+	if len(failed) > 0 {
+		color.Red("Failed to reach %d of %d host(s):", len(failed), len(results))
+		for _, f := range failed {
+			color.Red("  %s", f)
+		}
+		if len(failed) == len(results) {
+			return fmt.Errorf("all hosts failed")
+		}
+	}
 	return nil
 }
+
+// injectHost inserts a "host" field into a `docker ps --format
+// '{{json .}}'` line so a multi-host merged array can be traced back to
+// its source, without a full unmarshal/marshal round-trip.
+func injectHost(line, alias string) string {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return line
+	}
+	return `{"host":"` + alias + `",` + strings.TrimPrefix(line, "{")
+}
@@ -0,0 +1,49 @@
+package ssh
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExpandHostArgSplitsAndDedupes(t *testing.T) {
+	got, err := expandHostArg("prod-1, prod-2,prod-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"prod-1", "prod-2"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("expandHostArg() = %v, want %v", got, want)
+	}
+}
+
+func TestInjectHost(t *testing.T) {
+	got := injectHost(`{"Names":"web"}`, "prod-1")
+	want := `{"host":"prod-1","Names":"web"}`
+	if got != want {
+		t.Fatalf("injectHost() = %q, want %q", got, want)
+	}
+
+	if got := injectHost("not json", "prod-1"); got != "not json" {
+		t.Fatalf("injectHost() should pass non-JSON lines through unchanged, got %q", got)
+	}
+}
+
+func TestPrintResultsPartialFailure(t *testing.T) {
+	results := []hostResult{
+		{Alias: "prod-1", Lines: []string{"web: Up"}},
+		{Alias: "prod-2", Err: errors.New("dial timeout")},
+	}
+	if err := printResults(results, false); err != nil {
+		t.Fatalf("expected a partial failure to still succeed overall, got: %v", err)
+	}
+}
+
+func TestPrintResultsAllFailed(t *testing.T) {
+	results := []hostResult{
+		{Alias: "prod-1", Err: errors.New("dial timeout")},
+		{Alias: "prod-2", Err: errors.New("auth failed")},
+	}
+	if err := printResults(results, false); err == nil {
+		t.Fatal("expected an error when every host fails")
+	}
+}
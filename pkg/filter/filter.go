@@ -0,0 +1,182 @@
+// Package filter parses the repeatable `--filter key=value` flag shared by
+// monitor's container-listing subcommands (state, stats, logs, top, status
+// and watch) and applies it in two stages: keys Docker's Engine API
+// understands natively are forwarded to the daemon via filters.Args, while
+// computed keys Docker can't evaluate itself (uptime, cpu) are checked
+// in-process by Match.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+)
+
+// serverSideKeys are forwarded as-is to the Docker Engine API's
+// ContainerList via filters.Args.
+var serverSideKeys = map[string]bool{
+	"name":     true,
+	"id":       true,
+	"label":    true,
+	"status":   true,
+	"health":   true,
+	"network":  true,
+	"ancestor": true,
+	"exited":   true,
+	"before":   true,
+	"since":    true,
+}
+
+// computedFilter is a key Match evaluates in-process after the container
+// list comes back, because the Engine API has no equivalent server-side
+// filter for it.
+type computedFilter struct {
+	key   string
+	op    string
+	value string
+}
+
+// Matcher is the parsed result of one or more --filter flags: server-side
+// filters.Args to pass to ContainerList, plus computed filters for Match to
+// apply afterward.
+type Matcher struct {
+	Args     filters.Args
+	computed []computedFilter
+}
+
+// Parse parses repeatable --filter values such as "status=running",
+// "label=env=prod", or "uptime>1h" into a Matcher. See Help for the full
+// list of supported keys.
+func Parse(raw []string) (*Matcher, error) {
+	m := &Matcher{Args: filters.NewArgs()}
+	for _, f := range raw {
+		key, op, value, err := splitFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case serverSideKeys[key]:
+			if op != "=" {
+				return nil, fmt.Errorf("filter %q: %q only supports '=', not %q", f, key, op)
+			}
+			m.Args.Add(key, value)
+		case key == "uptime" || key == "cpu":
+			m.computed = append(m.computed, computedFilter{key: key, op: op, value: value})
+		default:
+			return nil, fmt.Errorf("unknown filter key %q (see --filter-help)", key)
+		}
+	}
+	return m, nil
+}
+
+// splitFilter splits "key<op>value" into its parts, trying the two-byte
+// comparison operators before the one-byte ones so "uptime>=1h" doesn't get
+// cut at the wrong place.
+func splitFilter(f string) (key, op, value string, err error) {
+	for _, candidate := range []string{">=", "<=", "!=", "=", ">", "<"} {
+		if idx := strings.Index(f, candidate); idx > 0 {
+			return strings.TrimSpace(f[:idx]), candidate, f[idx+len(candidate):], nil
+		}
+	}
+	return "", "", "", fmt.Errorf("invalid filter %q, expected key=value, key>value, etc.", f)
+}
+
+// NeedsCPU reports whether m has a "cpu" filter, so a caller can skip the
+// (expensive, one stats call per container) CPU computation when it's not
+// actually needed.
+func (m *Matcher) NeedsCPU() bool {
+	for _, cf := range m.computed {
+		if cf.key == "cpu" {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports whether cont satisfies every computed filter in m. cpuPercent
+// is only consulted if m has a "cpu" filter; pass 0 when NeedsCPU is false.
+// Server-side keys are assumed to already be applied via m.Args in
+// ContainerListOptions.Filters and are not re-checked here.
+func (m *Matcher) Match(cont types.Container, cpuPercent float64) bool {
+	for _, cf := range m.computed {
+		switch cf.key {
+		case "uptime":
+			d, err := time.ParseDuration(cf.value)
+			if err != nil {
+				return false
+			}
+			uptime := time.Since(time.Unix(cont.Created, 0))
+			if !compare(uptime.Seconds(), cf.op, d.Seconds()) {
+				return false
+			}
+		case "cpu":
+			threshold, err := strconv.ParseFloat(cf.value, 64)
+			if err != nil {
+				return false
+			}
+			if !compare(cpuPercent, cf.op, threshold) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CalculateCPUPercent mirrors the calculation "docker stats" uses. It lives
+// here, rather than in the docker package, so both the docker package
+// (StatsCmd) and the selector package (the "cpu" computed filter) can use
+// it without an import cycle between them.
+func CalculateCPUPercent(v *types.StatsJSON) float64 {
+	cpuDelta := float64(v.CPUStats.CPUUsage.TotalUsage - v.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(v.CPUStats.SystemUsage - v.PreCPUStats.SystemUsage)
+	if systemDelta > 0.0 && cpuDelta > 0.0 {
+		return (cpuDelta / systemDelta) * float64(len(v.CPUStats.CPUUsage.PercpuUsage)) * 100.0
+	}
+	return 0.0
+}
+
+func compare(value float64, op string, threshold float64) bool {
+	switch op {
+	case ">":
+		return value > threshold
+	case "<":
+		return value < threshold
+	case ">=":
+		return value >= threshold
+	case "<=":
+		return value <= threshold
+	case "=", "==":
+		return value == threshold
+	case "!=":
+		return value != threshold
+	default:
+		return false
+	}
+}
+
+// Help is the text printed by --filter-help: the supported keys, which side
+// evaluates them, and an example of each.
+const Help = `Supported --filter keys:
+
+  Forwarded to the Docker Engine API (server-side):
+    name=<name>         container name, e.g. --filter name=web
+    id=<id>              container ID (full or partial)
+    label=<key>[=<val>]  e.g. --filter label=env=prod
+    status=<status>      created|running|paused|restarting|exited|dead
+    health=<state>       starting|healthy|unhealthy|none
+    network=<name>       e.g. --filter network=bridge
+    ancestor=<image>     e.g. --filter ancestor=nginx:latest
+    exited=<code>        e.g. --filter exited=0
+    before=<container>   containers created before the given one
+    since=<container>    containers created after the given one
+
+  Evaluated in-process after the list comes back:
+    uptime>1h            container was created over 1h ago (also <, >=, <=, =, !=)
+    cpu>50               container's instantaneous CPU usage is over 50% (also <, >=, <=, =, !=)
+
+Repeat --filter for multiple conditions (all must match, i.e. AND).
+`
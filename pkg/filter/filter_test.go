@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+)
+
+func TestParseServerSide(t *testing.T) {
+	m, err := Parse([]string{"status=running", "label=env=prod"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !m.Args.Contains("status") || !m.Args.Contains("label") {
+		t.Fatalf("expected status and label to be forwarded server-side, got %v", m.Args)
+	}
+	if len(m.computed) != 0 {
+		t.Fatalf("expected no computed filters, got %v", m.computed)
+	}
+}
+
+func TestParseServerSideRejectsComparisonOps(t *testing.T) {
+	if _, err := Parse([]string{"status>running"}); err == nil {
+		t.Fatal("expected error for a comparison operator on a server-side key")
+	}
+}
+
+func TestParseUnknownKey(t *testing.T) {
+	if _, err := Parse([]string{"bogus=1"}); err == nil {
+		t.Fatal("expected error for an unknown filter key")
+	}
+}
+
+func TestMatchUptime(t *testing.T) {
+	m, err := Parse([]string{"uptime>1h"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	old := types.Container{Created: time.Now().Add(-2 * time.Hour).Unix()}
+	young := types.Container{Created: time.Now().Unix()}
+	if !m.Match(old, 0) {
+		t.Error("expected a 2h-old container to match uptime>1h")
+	}
+	if m.Match(young, 0) {
+		t.Error("expected a brand new container not to match uptime>1h")
+	}
+}
+
+func TestMatchCPU(t *testing.T) {
+	m, err := Parse([]string{"cpu>50"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !m.NeedsCPU() {
+		t.Fatal("expected NeedsCPU to be true for a cpu filter")
+	}
+	if !m.Match(types.Container{}, 75) {
+		t.Error("expected 75%% CPU to match cpu>50")
+	}
+	if m.Match(types.Container{}, 10) {
+		t.Error("expected 10%% CPU not to match cpu>50")
+	}
+}
+
+func TestCalculateCPUPercentZero(t *testing.T) {
+	var stats types.StatsJSON
+	if got := CalculateCPUPercent(&stats); got != 0 {
+		t.Fatalf("expected 0 CPU for empty stats, got %v", got)
+	}
+}
+
+func TestCalculateCPUPercent(t *testing.T) {
+	var stats types.StatsJSON
+	stats.CPUStats.CPUUsage.TotalUsage = 200
+	stats.PreCPUStats.CPUUsage.TotalUsage = 100
+	stats.CPUStats.SystemUsage = 1000
+	stats.PreCPUStats.SystemUsage = 500
+	stats.CPUStats.CPUUsage.PercpuUsage = make([]uint64, 2)
+
+	got := CalculateCPUPercent(&stats)
+	want := (100.0 / 500.0) * 2 * 100.0
+	if got != want {
+		t.Fatalf("CalculateCPUPercent() = %v, want %v", got, want)
+	}
+}